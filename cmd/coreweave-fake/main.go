@@ -0,0 +1,55 @@
+// Command coreweave-fake serves an in-memory fake of the CoreWeave API, for running Terraform configurations against
+// the provider without a CoreWeave account. See package fake for what it implements.
+//
+// Point the provider at it with the endpoint argument or COREWEAVE_API_ENDPOINT, and set any token:
+//
+//	coreweave-fake -addr 127.0.0.1:8080 &
+//	COREWEAVE_API_ENDPOINT=http://127.0.0.1:8080 COREWEAVE_API_TOKEN=fake terraform apply
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/coreweave/terraform-provider-coreweave/coreweave/fake"
+)
+
+func main() {
+	var (
+		addr  string
+		delay time.Duration
+	)
+
+	flag.StringVar(&addr, "addr", "127.0.0.1:8080", "address to listen on")
+	flag.DurationVar(&delay, "delay", fake.DefaultDelay, "how long resources stay in a transitional status, such as CREATING")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           fake.New(fake.WithDelay(delay)).Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Printf("[WARN] failed to shut down: %s", err)
+		}
+	}()
+
+	log.Printf("serving the fake CoreWeave API on http://%s", addr)
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal(err.Error())
+	}
+}
@@ -0,0 +1,147 @@
+package coreweave
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// AdoptExistingAttribute returns the schema of the adopt_existing attribute, for resources that can adopt a resource
+// left behind by an interrupted create. lookup names what identifies the resource, e.g. "name and zone".
+func AdoptExistingAttribute(lookup string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		MarkdownDescription: fmt.Sprintf(
+			"Adopt an existing resource with the same %s into state when creating this one fails because it already exists, e.g. because an earlier create was interrupted before the resource was recorded in state. The existing resource must match the configuration; otherwise, creating fails as usual. Defaults to `false`.",
+			lookup,
+		),
+	}
+}
+
+// IsAlreadyExistsError reports whether err is an API error with code AlreadyExists.
+func IsAlreadyExistsError(err error) bool {
+	var connectErr *connect.Error
+	return errors.As(err, &connectErr) && connectErr.Code() == connect.CodeAlreadyExists
+}
+
+// CreateRequestMismatches returns the names of the top-level fields that are set in the create request req, but have
+// a different value in existing, which is the resource or resource spec that an earlier create may have left behind.
+// Fields are matched by name, and only the fields set in req are compared, also within nested messages, so that values
+// defaulted by the server do not count as mismatches. Repeated fields are compared without regard to order. Fields that
+// existing does not have, or has with a different type, cannot be compared and are ignored.
+func CreateRequestMismatches(req, existing proto.Message) []string {
+	var mismatches []string
+
+	want, got := req.ProtoReflect(), existing.ProtoReflect()
+	want.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		gotFd := got.Descriptor().Fields().ByName(fd.Name())
+		if gotFd == nil || !comparableFields(fd, gotFd) {
+			return true
+		}
+		if !fieldMatches(fd, v, got.Get(gotFd)) {
+			mismatches = append(mismatches, string(fd.Name()))
+		}
+		return true
+	})
+
+	return mismatches
+}
+
+// FindAdoptable returns the item of existing that creating createReq failed to create because it already exists, i.e.
+// the item for which match returns true, so that it can be adopted into state. It adds an error and returns false if
+// there is no such item, or if its spec, as returned by spec, differs from createReq according to
+// CreateRequestMismatches.
+func FindAdoptable[T any](diagnostics *diag.Diagnostics, resourceType, name string, createReq proto.Message, existing []T, match func(T) bool, spec func(T) proto.Message) (T, bool) {
+	var zero T
+	for _, item := range existing {
+		if !match(item) {
+			continue
+		}
+		if mismatches := CreateRequestMismatches(createReq, spec(item)); len(mismatches) > 0 {
+			diagnostics.AddError(
+				"Already Exists",
+				fmt.Sprintf(
+					"%s '%s' already exists, but cannot be adopted because it differs from the configuration in: %s. Change the configuration to match it, or delete it and try again.",
+					resourceType, name, strings.Join(mismatches, ", "),
+				),
+			)
+			return zero, false
+		}
+		return item, true
+	}
+
+	diagnostics.AddError(
+		"Already Exists",
+		fmt.Sprintf("%s '%s' already exists, but it was not found to be adopted. It may exist in another zone, or be managed by another organization.", resourceType, name),
+	)
+	return zero, false
+}
+
+func comparableFields(a, b protoreflect.FieldDescriptor) bool {
+	if a.Kind() != b.Kind() || a.IsList() != b.IsList() || a.IsMap() != b.IsMap() {
+		return false
+	}
+	if a.IsMap() {
+		return comparableFields(a.MapKey(), b.MapKey()) && comparableFields(a.MapValue(), b.MapValue())
+	}
+	return true
+}
+
+func fieldMatches(fd protoreflect.FieldDescriptor, want, got protoreflect.Value) bool {
+	switch {
+	case fd.IsList():
+		wantList, gotList := want.List(), got.List()
+		if wantList.Len() != gotList.Len() {
+			return false
+		}
+		matched := make([]bool, gotList.Len())
+	outer:
+		for i := range wantList.Len() {
+			for j := range gotList.Len() {
+				if !matched[j] && valueMatches(fd, wantList.Get(i), gotList.Get(j)) {
+					matched[j] = true
+					continue outer
+				}
+			}
+			return false
+		}
+		return true
+
+	case fd.IsMap():
+		wantMap, gotMap := want.Map(), got.Map()
+		matches := true
+		wantMap.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			matches = gotMap.Has(k) && valueMatches(fd.MapValue(), v, gotMap.Get(k))
+			return matches
+		})
+		return matches
+
+	default:
+		return valueMatches(fd, want, got)
+	}
+}
+
+// valueMatches compares a single value of fd, i.e. an element if fd is repeated.
+func valueMatches(fd protoreflect.FieldDescriptor, want, got protoreflect.Value) bool {
+	if fd.Kind() != protoreflect.MessageKind && fd.Kind() != protoreflect.GroupKind {
+		return want.Equal(got)
+	}
+
+	gotMsg := got.Message()
+	matches := true
+	want.Message().Range(func(wantFd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		gotFd := gotMsg.Descriptor().Fields().ByName(wantFd.Name())
+		if gotFd == nil || !comparableFields(wantFd, gotFd) {
+			return true
+		}
+		matches = fieldMatches(wantFd, v, gotMsg.Get(gotFd))
+		return matches
+	})
+	return matches
+}
@@ -0,0 +1,147 @@
+package coreweave_test
+
+import (
+	"errors"
+	"testing"
+
+	cksv1beta1 "buf.build/gen/go/coreweave/cks/protocolbuffers/go/coreweave/cks/v1beta1"
+	networkingv1beta1 "buf.build/gen/go/coreweave/networking/protocolbuffers/go/coreweave/networking/v1beta1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestCreateRequestMismatches(t *testing.T) {
+	t.Parallel()
+
+	existingVpc := &networkingv1beta1.VPC{
+		Id:         "vpc-1",
+		Name:       "default",
+		Zone:       "US-EAST-04A",
+		Status:     networkingv1beta1.VPC_STATUS_READY,
+		HostPrefix: "10.16.192.0/18",
+		VpcPrefixes: []*networkingv1beta1.Prefix{
+			{Name: "pod-cidr", Value: "10.0.0.0/16"},
+			{Name: "service-cidr", Value: "10.16.0.0/16"},
+		},
+		Egress: &networkingv1beta1.Egress{DisablePublicAccess: true},
+	}
+
+	tests := []struct {
+		name     string
+		req      proto.Message
+		existing proto.Message
+		want     []string
+	}{
+		{
+			name: "matching, with values defaulted by the server",
+			req: &networkingv1beta1.CreateVPCRequest{
+				Name: "default",
+				Zone: "US-EAST-04A",
+				VpcPrefixes: []*networkingv1beta1.Prefix{
+					{Name: "service-cidr", Value: "10.16.0.0/16"},
+					{Name: "pod-cidr", Value: "10.0.0.0/16"},
+				},
+				Ingress: &networkingv1beta1.Ingress{},
+				Egress:  &networkingv1beta1.Egress{DisablePublicAccess: true},
+			},
+			existing: existingVpc,
+		},
+		{
+			name: "mismatched",
+			req: &networkingv1beta1.CreateVPCRequest{
+				Name:        "default",
+				Zone:        "US-EAST-04A",
+				HostPrefix:  "172.16.0.0/18",
+				VpcPrefixes: []*networkingv1beta1.Prefix{{Name: "pod-cidr", Value: "10.0.0.0/16"}},
+				Ingress:     &networkingv1beta1.Ingress{DisablePublicServices: true},
+			},
+			existing: existingVpc,
+			want:     []string{"vpc_prefixes", "host_prefix", "ingress"},
+		},
+		{
+			name: "request-only fields are ignored",
+			req: &cksv1beta1.CreateClusterRequest{
+				Name:                   "cluster",
+				SharedStorageClusterId: "other-cluster",
+				Network:                &cksv1beta1.ClusterNetworkConfig{PodCidrName: "pod-cidr"},
+				Kubelet:                &structpb.Struct{Fields: map[string]*structpb.Value{"maxPods": structpb.NewNumberValue(110)}},
+			},
+			existing: &cksv1beta1.Cluster{
+				Name:    "cluster",
+				Network: &cksv1beta1.ClusterNetworkConfig{PodCidrName: "pod-cidr", ServiceCidrName: "service-cidr"},
+				Kubelet: &structpb.Struct{Fields: map[string]*structpb.Value{"maxPods": structpb.NewNumberValue(110)}},
+			},
+		},
+		{
+			name: "nested mismatch",
+			req: &cksv1beta1.CreateClusterRequest{
+				Name:    "cluster",
+				Network: &cksv1beta1.ClusterNetworkConfig{PodCidrName: "other-pod-cidr"},
+				Kubelet: &structpb.Struct{Fields: map[string]*structpb.Value{"maxPods": structpb.NewNumberValue(250)}},
+			},
+			existing: &cksv1beta1.Cluster{
+				Name:    "cluster",
+				Network: &cksv1beta1.ClusterNetworkConfig{PodCidrName: "pod-cidr"},
+				Kubelet: &structpb.Struct{Fields: map[string]*structpb.Value{"maxPods": structpb.NewNumberValue(110)}},
+			},
+			want: []string{"network", "kubelet"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ElementsMatch(t, tt.want, coreweave.CreateRequestMismatches(tt.req, tt.existing))
+		})
+	}
+}
+
+func TestIsAlreadyExistsError(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, coreweave.IsAlreadyExistsError(connect.NewError(connect.CodeAlreadyExists, errors.New("exists"))))
+	assert.False(t, coreweave.IsAlreadyExistsError(connect.NewError(connect.CodeNotFound, errors.New("missing"))))
+	assert.False(t, coreweave.IsAlreadyExistsError(errors.New("exists")))
+}
+
+func TestFindAdoptable(t *testing.T) {
+	t.Parallel()
+
+	existing := []*networkingv1beta1.VPC{
+		{Id: "vpc-1", Name: "default", Zone: "US-EAST-04A", HostPrefix: "10.16.192.0/18"},
+		{Id: "vpc-2", Name: "default", Zone: "US-WEST-01A", HostPrefix: "172.16.0.0/18"},
+	}
+	find := func(req *networkingv1beta1.CreateVPCRequest) (*networkingv1beta1.VPC, diag.Diagnostics) {
+		var diagnostics diag.Diagnostics
+		vpc, _ := coreweave.FindAdoptable(&diagnostics, "VPC", req.GetName(), req, existing,
+			func(vpc *networkingv1beta1.VPC) bool {
+				return vpc.GetName() == req.GetName() && vpc.GetZone() == req.GetZone()
+			},
+			func(vpc *networkingv1beta1.VPC) proto.Message { return vpc },
+		)
+		return vpc, diagnostics
+	}
+
+	vpc, diagnostics := find(&networkingv1beta1.CreateVPCRequest{Name: "default", Zone: "US-WEST-01A", HostPrefix: "172.16.0.0/18"})
+	assert.Empty(t, diagnostics)
+	assert.Equal(t, "vpc-2", vpc.GetId())
+
+	vpc, diagnostics = find(&networkingv1beta1.CreateVPCRequest{Name: "default", Zone: "US-EAST-04A", HostPrefix: "172.16.0.0/18"})
+	assert.Nil(t, vpc)
+	assert.Equal(t, diag.Diagnostics{diag.NewErrorDiagnostic(
+		"Already Exists",
+		"VPC 'default' already exists, but cannot be adopted because it differs from the configuration in: host_prefix. Change the configuration to match it, or delete it and try again.",
+	)}, diagnostics)
+
+	vpc, diagnostics = find(&networkingv1beta1.CreateVPCRequest{Name: "default", Zone: "US-LAS-01A"})
+	assert.Nil(t, vpc)
+	assert.Equal(t, diag.Diagnostics{diag.NewErrorDiagnostic(
+		"Already Exists",
+		"VPC 'default' already exists, but it was not found to be adopted. It may exist in another zone, or be managed by another organization.",
+	)}, diagnostics)
+}
@@ -0,0 +1,625 @@
+package coreweave
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// CassetteMode selects whether a Cassette records or replays traffic.
+type CassetteMode int
+
+const (
+	// CassetteRecord sends requests as usual and records them to the cassette file, after any interactions it
+	// already holds, so that the commands of a session, e.g. plan and then apply, end up in a single file.
+	CassetteRecord CassetteMode = iota + 1
+	// CassetteReplay serves responses from the cassette file and never sends a request.
+	CassetteReplay
+)
+
+var (
+	// Cassettes are shared by every client in the process, as the provider builds a new client each time it is
+	// configured, and recordings must not overwrite each other.
+	cassettesMu sync.Mutex
+	cassettes   = map[cassetteKey]*Cassette{}
+
+	// sensitiveQueryParams are the query parameters of presigned S3 URLs that are never recorded.
+	sensitiveQueryParams = []string{"X-Amz-Credential", "X-Amz-Security-Token", "X-Amz-Signature"}
+)
+
+// connectProtocolVersionHeader is set on every request of the Connect protocol, which distinguishes API calls from S3
+// requests.
+const connectProtocolVersionHeader = "Connect-Protocol-Version"
+
+type cassetteKey struct {
+	path string
+	mode CassetteMode
+}
+
+// Cassette records the CoreWeave API calls and S3 HTTP exchanges of a Client to a file, and replays them later without
+// network access, e.g. to reproduce a bug offline. Secrets are scrubbed before anything is written: sensitive message
+// fields and headers are redacted as they are in the logs, and so are the signatures of presigned S3 URLs.
+//
+// API calls are recorded by Interceptor, which sees their decoded messages, and S3 requests by Transport. When
+// replaying, Transport serves both. Recorded requests are matched in order: each is replayed once, except that the last
+// match of a request is replayed again once all of them have been, so that polls keep returning the final state.
+type Cassette struct {
+	path string
+	mode CassetteMode
+
+	mu           sync.Mutex
+	interactions []cassetteInteraction
+	replayed     []bool
+}
+
+// cassetteVersion is the version of the cassette file format.
+const cassetteVersion = 1
+
+type cassetteFile struct {
+	Version      int                   `json:"version"`
+	Interactions []cassetteInteraction `json:"interactions"`
+}
+
+// cassetteInteraction is either an API call or an HTTP exchange.
+type cassetteInteraction struct {
+	Call     *cassetteCall     `json:"call,omitempty"`
+	Exchange *cassetteExchange `json:"exchange,omitempty"`
+}
+
+// cassetteCall is a unary Connect call. Messages are stored as protojson.
+type cassetteCall struct {
+	Procedure string          `json:"procedure"`
+	Request   json.RawMessage `json:"request"`
+	Response  json.RawMessage `json:"response,omitempty"`
+	Error     *cassetteError  `json:"error,omitempty"`
+}
+
+type cassetteError struct {
+	Code    string                `json:"code"`
+	Message string                `json:"message"`
+	Details []cassetteErrorDetail `json:"details,omitempty"`
+}
+
+type cassetteErrorDetail struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+type cassetteExchange struct {
+	Method         string       `json:"method"`
+	URL            string       `json:"url"`
+	RequestHeader  http.Header  `json:"request_header,omitempty"`
+	RequestBody    cassetteBody `json:"request_body,omitempty"`
+	StatusCode     int          `json:"status_code"`
+	ResponseHeader http.Header  `json:"response_header,omitempty"`
+	ResponseBody   cassetteBody `json:"response_body,omitempty"`
+}
+
+// cassetteBody is an HTTP body, stored as a string if it is valid UTF-8 and as base64 otherwise.
+type cassetteBody []byte
+
+type cassetteBinaryBody struct {
+	Base64 string `json:"base64"`
+}
+
+func (b cassetteBody) MarshalJSON() ([]byte, error) {
+	if utf8.Valid(b) {
+		return marshalCassetteJSON(string(b), "")
+	}
+	return marshalCassetteJSON(cassetteBinaryBody{Base64: base64.StdEncoding.EncodeToString(b)}, "")
+}
+
+func (b *cassetteBody) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*b = []byte(text)
+		return nil
+	}
+
+	var binary cassetteBinaryBody
+	if err := json.Unmarshal(data, &binary); err != nil {
+		return err
+	}
+	decoded, err := base64.StdEncoding.DecodeString(binary.Base64)
+	if err != nil {
+		return err
+	}
+	*b = decoded
+	return nil
+}
+
+// OpenCassette returns the cassette at path. Opening a cassette that does not exist for recording creates it, while
+// one that is replayed must exist.
+func OpenCassette(path string, mode CassetteMode) (*Cassette, error) {
+	if mode != CassetteRecord && mode != CassetteReplay {
+		return nil, fmt.Errorf("invalid cassette mode %d", mode)
+	}
+
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cassette path: %w", err)
+	}
+
+	cassettesMu.Lock()
+	defer cassettesMu.Unlock()
+
+	key := cassetteKey{path: path, mode: mode}
+	if c, ok := cassettes[key]; ok {
+		return c, nil
+	}
+
+	c := &Cassette{path: path, mode: mode}
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist) && mode == CassetteRecord:
+	case err != nil:
+		return nil, fmt.Errorf("failed to read cassette: %w", err)
+	default:
+		var file cassetteFile
+		if err := json.Unmarshal(data, &file); err != nil {
+			return nil, fmt.Errorf("failed to parse cassette %q: %w", path, err)
+		}
+		if file.Version != cassetteVersion {
+			return nil, fmt.Errorf("cassette %q has unsupported version %d", path, file.Version)
+		}
+		c.interactions = file.Interactions
+	}
+	c.replayed = make([]bool, len(c.interactions))
+
+	cassettes[key] = c
+	return c, nil
+}
+
+// Path returns the absolute path of the cassette file.
+func (c *Cassette) Path() string {
+	return c.path
+}
+
+// Mode returns whether the cassette records or replays.
+func (c *Cassette) Mode() CassetteMode {
+	return c.mode
+}
+
+// record appends interaction to the cassette and rewrites the file, so that the recording survives the provider
+// process being stopped at any point.
+func (c *Cassette) record(interaction cassetteInteraction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.interactions = append(c.interactions, interaction)
+
+	data, err := marshalCassetteJSON(cassetteFile{Version: cassetteVersion, Interactions: c.interactions}, "  ")
+	if err != nil {
+		return err
+	}
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, c.path)
+}
+
+// marshalCassetteJSON marshals v without escaping HTML characters, which are common in S3's XML bodies and in
+// redacted values, so that cassettes stay readable.
+func marshalCassetteJSON(v any, indent string) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", indent)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// replay returns the first interaction that matches and has not been replayed yet, or the last one that matches if all
+// of them have been.
+func (c *Cassette) replay(match func(cassetteInteraction) bool) (cassetteInteraction, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	last := -1
+	for i, interaction := range c.interactions {
+		if !match(interaction) {
+			continue
+		}
+		if !c.replayed[i] {
+			c.replayed[i] = true
+			return interaction, true
+		}
+		last = i
+	}
+	if last < 0 {
+		return cassetteInteraction{}, false
+	}
+	return c.interactions[last], true
+}
+
+// Interceptor returns an interceptor that records unary API calls when recording, and does nothing otherwise.
+func (c *Cassette) Interceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		if c.mode != CassetteRecord {
+			return next
+		}
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			resp, err := next(ctx, req)
+			if recordErr := c.recordCall(req, resp, err); recordErr != nil {
+				tflog.Warn(ctx, "failed to record API call to cassette", map[string]any{
+					"procedure": req.Spec().Procedure,
+					"error":     recordErr.Error(),
+				})
+			}
+			return resp, err
+		}
+	})
+}
+
+func (c *Cassette) recordCall(req connect.AnyRequest, resp connect.AnyResponse, callErr error) error {
+	call := &cassetteCall{Procedure: req.Spec().Procedure}
+
+	reqMsg, ok := messageOf(req)
+	if !ok {
+		return fmt.Errorf("%T.Msg is not a proto.Message", req)
+	}
+	var err error
+	if call.Request, err = marshalCassetteMessage(reqMsg); err != nil {
+		return err
+	}
+
+	if callErr != nil {
+		if call.Error, err = newCassetteError(callErr); err != nil {
+			return err
+		}
+	} else if respMsg, ok := messageOf(resp); ok {
+		if call.Response, err = marshalCassetteMessage(respMsg); err != nil {
+			return err
+		}
+	}
+
+	return c.record(cassetteInteraction{Call: call})
+}
+
+// marshalCassetteMessage marshals msg as protojson, with sensitive fields redacted.
+func marshalCassetteMessage(msg proto.Message) (json.RawMessage, error) {
+	return protojson.Marshal(redactMessage(msg))
+}
+
+func newCassetteError(err error) (*cassetteError, error) {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		return &cassetteError{Code: connect.CodeUnknown.String(), Message: err.Error()}, nil
+	}
+
+	recorded := &cassetteError{Code: connectErr.Code().String(), Message: connectErr.Message()}
+	for _, detail := range connectErr.Details() {
+		msg, err := detail.Value()
+		if err != nil {
+			// the detail's type is not linked into the provider, so it could not be replayed either
+			continue
+		}
+		value, err := marshalCassetteMessage(msg)
+		if err != nil {
+			return nil, err
+		}
+		recorded.Details = append(recorded.Details, cassetteErrorDetail{Type: detail.Type(), Value: value})
+	}
+	return recorded, nil
+}
+
+// Transport returns a RoundTripper that records the HTTP exchanges sent through next when recording, except for API
+// calls, which Interceptor records. When replaying, it serves API calls and HTTP exchanges from the cassette instead of
+// sending them. It should wrap any retrying transport, so that only the outcome of the retries is recorded.
+func (c *Cassette) Transport(next http.RoundTripper) http.RoundTripper {
+	return &cassetteTransport{cassette: c, next: next}
+}
+
+type cassetteTransport struct {
+	cassette *Cassette
+	next     http.RoundTripper
+}
+
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	isCall := req.Header.Get(connectProtocolVersionHeader) != ""
+
+	switch {
+	case t.cassette.mode == CassetteReplay && isCall:
+		return t.cassette.replayCall(req)
+	case t.cassette.mode == CassetteReplay:
+		return t.cassette.replayExchange(req)
+	case isCall:
+		return t.next.RoundTrip(req)
+	default:
+		return t.recordExchange(req)
+	}
+}
+
+func (t *cassetteTransport) recordExchange(req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	if req.Body != nil {
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := readBody(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	recordErr := t.cassette.record(cassetteInteraction{Exchange: &cassetteExchange{
+		Method:         req.Method,
+		URL:            scrubURL(req.URL),
+		RequestHeader:  scrubHeader(req.Header),
+		RequestBody:    reqBody,
+		StatusCode:     resp.StatusCode,
+		ResponseHeader: scrubHeader(resp.Header),
+		ResponseBody:   respBody,
+	}})
+	if recordErr != nil {
+		tflog.Warn(req.Context(), "failed to record HTTP exchange to cassette", map[string]any{
+			"url":   scrubURL(req.URL),
+			"error": recordErr.Error(),
+		})
+	}
+
+	return resp, nil
+}
+
+func (c *Cassette) replayExchange(req *http.Request) (*http.Response, error) {
+	if _, err := readBody(req.Body); err != nil {
+		return nil, err
+	}
+
+	u := scrubURL(req.URL)
+	interaction, ok := c.replay(func(i cassetteInteraction) bool {
+		return i.Exchange != nil && i.Exchange.Method == req.Method && i.Exchange.URL == u
+	})
+	if !ok {
+		return nil, fmt.Errorf("cassette %q has no recorded response for %s %s", c.path, req.Method, u)
+	}
+
+	exchange := interaction.Exchange
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", exchange.StatusCode, http.StatusText(exchange.StatusCode)),
+		StatusCode:    exchange.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        exchange.ResponseHeader.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(exchange.ResponseBody)),
+		ContentLength: int64(len(exchange.ResponseBody)),
+		Request:       req,
+	}, nil
+}
+
+func (c *Cassette) replayCall(req *http.Request) (*http.Response, error) {
+	w := newBufferedResponse()
+
+	if err := c.writeReplayedCall(w, req); err != nil {
+		if writeErr := connect.NewErrorWriter().Write(w, req, err); writeErr != nil {
+			return nil, writeErr
+		}
+	}
+	return w.response(req), nil
+}
+
+// writeReplayedCall writes the recorded response to the API call in req, returning any error to be written instead.
+func (c *Cassette) writeReplayedCall(w http.ResponseWriter, req *http.Request) error {
+	body, err := readBody(req.Body)
+	if err != nil {
+		return connect.NewError(connect.CodeUnknown, err)
+	}
+
+	procedure, method, err := procedureOf(req.URL)
+	if err != nil {
+		return connect.NewError(connect.CodeUnimplemented, err)
+	}
+	mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	codec, ok := cassetteCodecs[mediaType]
+	if !ok {
+		return connect.NewError(connect.CodeUnimplemented, fmt.Errorf("unsupported content type %q", mediaType))
+	}
+
+	reqMsg, err := newMessage(method.Input().FullName())
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if err := codec.unmarshal(body, reqMsg); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	reqMsg = redactMessage(reqMsg)
+
+	interaction, ok := c.replay(func(i cassetteInteraction) bool {
+		if i.Call == nil || i.Call.Procedure != procedure {
+			return false
+		}
+		recorded := reqMsg.ProtoReflect().New().Interface()
+		return protojson.Unmarshal(i.Call.Request, recorded) == nil && proto.Equal(recorded, reqMsg)
+	})
+	if !ok {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("cassette %q has no recorded response for %s %s", c.path, procedure, logFormatMessage(reqMsg)))
+	}
+
+	call := interaction.Call
+	if call.Error != nil {
+		return call.Error.err()
+	}
+
+	respMsg, err := newMessage(method.Output().FullName())
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if err := protojson.Unmarshal(call.Response, respMsg); err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("invalid recorded response: %w", err))
+	}
+	respBody, err := codec.marshal(respMsg)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	w.Header().Set("Content-Type", mediaType)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+	return nil
+}
+
+// err rebuilds the recorded error, with the details whose types are known.
+func (e *cassetteError) err() error {
+	var code connect.Code
+	if err := code.UnmarshalText([]byte(e.Code)); err != nil {
+		code = connect.CodeUnknown
+	}
+
+	connectErr := connect.NewError(code, errors.New(e.Message))
+	for _, recorded := range e.Details {
+		msg, err := newMessage(protoreflect.FullName(recorded.Type))
+		if err != nil || protojson.Unmarshal(recorded.Value, msg) != nil {
+			continue
+		}
+		if detail, err := connect.NewErrorDetail(msg); err == nil {
+			connectErr.AddDetail(detail)
+		}
+	}
+	return connectErr
+}
+
+type cassetteCodec struct {
+	marshal   func(proto.Message) ([]byte, error)
+	unmarshal func([]byte, proto.Message) error
+}
+
+// cassetteCodecs are the codecs of the Connect unary protocol, by content type.
+var cassetteCodecs = map[string]cassetteCodec{
+	"application/proto": {marshal: proto.Marshal, unmarshal: proto.Unmarshal},
+	"application/json":  {marshal: protojson.Marshal, unmarshal: protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal},
+}
+
+// procedureOf returns the procedure of an API call, e.g. "/coreweave.cks.v1beta1.ClusterService/GetCluster", from the
+// last two segments of its URL path, and the descriptor of its method.
+func procedureOf(u *url.URL) (string, protoreflect.MethodDescriptor, error) {
+	segments := strings.Split(strings.TrimSuffix(u.Path, "/"), "/")
+	if len(segments) < 2 {
+		return "", nil, fmt.Errorf("%q is not the path of a procedure", u.Path)
+	}
+	service, name := segments[len(segments)-2], segments[len(segments)-1]
+
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return "", nil, fmt.Errorf("unknown service %q: %w", service, err)
+	}
+	serviceDesc, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return "", nil, fmt.Errorf("%q is not a service", service)
+	}
+	method := serviceDesc.Methods().ByName(protoreflect.Name(name))
+	if method == nil {
+		return "", nil, fmt.Errorf("unknown method %q of service %q", name, service)
+	}
+
+	return "/" + service + "/" + name, method, nil
+}
+
+func newMessage(name protoreflect.FullName) (proto.Message, error) {
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown message type %q: %w", name, err)
+	}
+	return messageType.New().Interface(), nil
+}
+
+// readBody reads and closes body, which may be nil.
+func readBody(body io.ReadCloser) ([]byte, error) {
+	if body == nil || body == http.NoBody {
+		return nil, nil
+	}
+	defer body.Close()
+	return io.ReadAll(body)
+}
+
+// scrubURL returns u with the values of sensitive query parameters redacted. The query is always re-encoded, so that
+// recorded and replayed URLs compare equal.
+func scrubURL(u *url.URL) string {
+	scrubbed := *u
+	query := scrubbed.Query()
+	for _, param := range sensitiveQueryParams {
+		if query.Has(param) {
+			query.Set(param, redactedValue)
+		}
+	}
+	scrubbed.RawQuery = query.Encode()
+	return scrubbed.String()
+}
+
+// scrubHeader returns a copy of header with the values of sensitive headers redacted.
+func scrubHeader(header http.Header) http.Header {
+	scrubbed := header.Clone()
+	for name := range scrubbed {
+		if sensitiveHeaders[http.CanonicalHeaderKey(name)] {
+			scrubbed[name] = []string{redactedValue}
+		}
+	}
+	return scrubbed
+}
+
+// bufferedResponse is an http.ResponseWriter that buffers a response in memory.
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newBufferedResponse() *bufferedResponse {
+	return &bufferedResponse{header: http.Header{}, status: http.StatusOK}
+}
+
+func (w *bufferedResponse) Header() http.Header {
+	return w.header
+}
+
+func (w *bufferedResponse) Write(p []byte) (int, error) {
+	return w.body.Write(p)
+}
+
+func (w *bufferedResponse) WriteHeader(status int) {
+	w.status = status
+}
+
+func (w *bufferedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", w.status, http.StatusText(w.status)),
+		StatusCode:    w.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        w.header,
+		Body:          io.NopCloser(bytes.NewReader(w.body.Bytes())),
+		ContentLength: int64(w.body.Len()),
+		Request:       req,
+	}
+}
@@ -0,0 +1,167 @@
+package coreweave
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	cwobjectv1 "buf.build/gen/go/coreweave/cwobject/protocolbuffers/go/cwobject/v1"
+	networkingv1beta1 "buf.build/gen/go/coreweave/networking/protocolbuffers/go/coreweave/networking/v1beta1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const testCassetteToken = "cassette-test-token"
+
+func bearerTokenInterceptor(token string) connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			req.Header().Set("Authorization", "Bearer "+token)
+			return next(ctx, req)
+		}
+	})
+}
+
+func newCassetteClient(t *testing.T, endpoint, s3Endpoint string, cassette *Cassette) *Client {
+	t.Helper()
+
+	client, err := NewClient(endpoint, s3Endpoint, 10*time.Second, RetryConfig{}, TransportConfig{Cassette: cassette}, bearerTokenInterceptor(testCassetteToken))
+	require.NoError(t, err)
+	return client
+}
+
+// cassetteSession makes the same calls against whichever servers client is configured with, and returns what it saw.
+type cassetteSession struct {
+	vpc          *networkingv1beta1.VPC
+	notFound     *connect.Error
+	accessKey    *cwobjectv1.CreateAccessKeyFromJWTResponse
+	s3StatusCode int
+	s3Body       string
+}
+
+func runCassetteSession(t *testing.T, client *Client, s3Endpoint string) cassetteSession {
+	t.Helper()
+	ctx := t.Context()
+	var session cassetteSession
+
+	created, err := client.CreateVPC(ctx, connect.NewRequest(&networkingv1beta1.CreateVPCRequest{Name: "recorded", Zone: "US-EAST-04A"}))
+	require.NoError(t, err)
+
+	// polls are replayed in order
+	for range 2 {
+		got, err := client.GetVPC(ctx, connect.NewRequest(&networkingv1beta1.GetVPCRequest{Id: created.Msg.GetVpc().GetId()}))
+		require.NoError(t, err)
+		session.vpc = got.Msg.GetVpc()
+	}
+
+	_, err = client.GetVPC(ctx, connect.NewRequest(&networkingv1beta1.GetVPCRequest{Id: "missing"}))
+	require.ErrorAs(t, err, &session.notFound)
+
+	key, err := client.CreateAccessKeyFromJWT(ctx, connect.NewRequest(&cwobjectv1.CreateAccessKeyFromJWTRequest{DurationSeconds: wrapperspb.UInt32(60)}))
+	require.NoError(t, err)
+	session.accessKey = key.Msg
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s3Endpoint+"/bucket?tagging=&X-Amz-Signature=s3-signature", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential=s3-credential")
+	resp, err := client.s3HttpClient().Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	session.s3StatusCode = resp.StatusCode
+	session.s3Body = string(body)
+
+	return session
+}
+
+func TestCassette_RecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	api := httptest.NewServer(fake.New(fake.WithDelay(0)).Handler())
+	s3Server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = io.WriteString(w, "<Tagging><TagSet><Tag><Key>env</Key><Value>test</Value></Tag></TagSet></Tagging>")
+	}))
+
+	recorder, err := OpenCassette(path, CassetteRecord)
+	require.NoError(t, err)
+	recorded := runCassetteSession(t, newCassetteClient(t, api.URL, s3Server.URL, recorder), s3Server.URL)
+
+	assert.Equal(t, networkingv1beta1.VPC_STATUS_READY, recorded.vpc.GetStatus())
+	assert.Equal(t, connect.CodeNotFound, recorded.notFound.Code())
+	assert.NotEqual(t, redactedValue, recorded.accessKey.GetSecretKey(), "the caller gets the real secret")
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	for _, secret := range []string{testCassetteToken, recorded.accessKey.GetSecretKey(), "s3-signature", "s3-credential"} {
+		assert.NotContains(t, string(data), secret)
+	}
+	assert.Contains(t, string(data), redactedValue)
+
+	// everything is served from the cassette once the servers are gone
+	api.Close()
+	s3Server.Close()
+
+	player, err := OpenCassette(path, CassetteReplay)
+	require.NoError(t, err)
+	replayClient := newCassetteClient(t, api.URL, s3Server.URL, player)
+	replayed := runCassetteSession(t, replayClient, s3Server.URL)
+
+	assert.Equal(t, recorded.vpc.GetId(), replayed.vpc.GetId())
+	assert.Equal(t, networkingv1beta1.VPC_STATUS_READY, replayed.vpc.GetStatus())
+	assert.Equal(t, connect.CodeNotFound, replayed.notFound.Code())
+	assert.Equal(t, recorded.notFound.Message(), replayed.notFound.Message())
+	require.Len(t, replayed.notFound.Details(), 1)
+	detail, err := replayed.notFound.Details()[0].Value()
+	require.NoError(t, err)
+	assert.IsType(t, &errdetails.ResourceInfo{}, detail)
+	assert.Equal(t, recorded.accessKey.GetAccessKeyId(), replayed.accessKey.GetAccessKeyId())
+	assert.Equal(t, redactedValue, replayed.accessKey.GetSecretKey())
+	assert.Equal(t, recorded.s3StatusCode, replayed.s3StatusCode)
+	assert.Equal(t, recorded.s3Body, replayed.s3Body)
+
+	// requests that were not recorded fail rather than reaching the network
+	_, err = replayClient.GetVPC(t.Context(), connect.NewRequest(&networkingv1beta1.GetVPCRequest{Id: "never-requested"}))
+	var connectErr *connect.Error
+	require.ErrorAs(t, err, &connectErr)
+	assert.Equal(t, connect.CodeFailedPrecondition, connectErr.Code())
+	assert.Contains(t, connectErr.Message(), "no recorded response")
+}
+
+func TestCassette_ReplayRequiresFile(t *testing.T) {
+	_, err := OpenCassette(filepath.Join(t.TempDir(), "missing.json"), CassetteReplay)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestCassette_RecordAppends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	body := cassetteBody([]byte{0xff, 0x00})
+
+	c, err := OpenCassette(path, CassetteRecord)
+	require.NoError(t, err)
+	require.NoError(t, c.record(cassetteInteraction{Exchange: &cassetteExchange{Method: http.MethodGet, URL: "https://example.com/a", StatusCode: http.StatusOK, ResponseBody: body}}))
+
+	// a new process opening the cassette for recording keeps what was recorded before
+	cassettesMu.Lock()
+	delete(cassettes, cassetteKey{path: path, mode: CassetteRecord})
+	cassettesMu.Unlock()
+
+	c, err = OpenCassette(path, CassetteRecord)
+	require.NoError(t, err)
+	require.NoError(t, c.record(cassetteInteraction{Exchange: &cassetteExchange{Method: http.MethodGet, URL: "https://example.com/b", StatusCode: http.StatusOK}}))
+
+	c, err = OpenCassette(path, CassetteReplay)
+	require.NoError(t, err)
+	require.Len(t, c.interactions, 2)
+	assert.Equal(t, body, c.interactions[0].Exchange.ResponseBody)
+}
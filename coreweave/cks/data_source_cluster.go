@@ -11,13 +11,19 @@ import (
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ datasource.DataSource = &ClusterDataSource{}
+	_ datasource.DataSource                     = &ClusterDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &ClusterDataSource{}
 )
 
 func NewClusterDataSource() datasource.DataSource {
@@ -36,157 +42,185 @@ func (d *ClusterDataSource) Metadata(ctx context.Context, req datasource.Metadat
 }
 
 func (d *ClusterDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := clusterDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The ID of the cluster. Exactly one of `id` or `name` must be set.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the cluster. Exactly one of `id` or `name` must be set. Looking a cluster up by name fails if there is no cluster with the name, or if there are several in different zones and `zone` is not set.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["zone"] = schema.StringAttribute{
+		MarkdownDescription: "The zone of the cluster. When looking a cluster up by `name`, only the clusters in this zone are considered.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(path.MatchRoot("id")),
+		},
+	}
+
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Query information about an existing CoreWeave Kubernetes Service (CKS) cluster by ID. See the [CKS API reference](https://docs.coreweave.com/products/cks/reference/cks-api).",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the cluster.",
-				Required:            true,
-			},
-			"vpc_id": schema.StringAttribute{
-				MarkdownDescription: "The VPC ID of the cluster.",
-				Computed:            true,
-			},
-			"zone": schema.StringAttribute{
-				MarkdownDescription: "The zone of the cluster.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the cluster.",
-				Computed:            true,
-			},
-			"version": schema.StringAttribute{
-				MarkdownDescription: "The version of the cluster.",
-				Computed:            true,
-			},
-			"public": schema.BoolAttribute{
-				MarkdownDescription: "Whether the cluster is public.",
-				Computed:            true,
-			},
-			"pod_cidr_name": schema.StringAttribute{
-				MarkdownDescription: "The pod CIDR name of the cluster.",
-				Computed:            true,
-			},
-			"service_cidr_name": schema.StringAttribute{
-				MarkdownDescription: "The service CIDR name of the cluster.",
-				Computed:            true,
-			},
-			"internal_lb_cidr_names": schema.ListAttribute{
-				MarkdownDescription: "The internal load balancer CIDR names of the cluster.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			// v6 fields
-			"pod_cidr_name_v6": schema.StringAttribute{
-				MarkdownDescription: "The IPv6 pod CIDR name of the cluster.",
-				Computed:            true,
-			},
-			"service_cidr_name_v6": schema.StringAttribute{
-				MarkdownDescription: "The IPv6 service CIDR name of the cluster.",
-				Computed:            true,
-			},
-			"internal_lb_cidr_names_v6": schema.ListAttribute{
-				MarkdownDescription: "The IPv6 internal load balancer CIDR names of the cluster.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"node_port_range": schema.SingleNestedAttribute{
-				MarkdownDescription: "The Kubernetes Service NodePort range.",
-				Computed:            true,
-				Attributes: map[string]schema.Attribute{
-					"start": schema.Int32Attribute{
-						MarkdownDescription: "Start of the NodePort range.",
-						Computed:            true,
-					},
-					"end": schema.Int32Attribute{
-						MarkdownDescription: "End of the NodePort range.",
-						Computed:            true,
-					},
+		MarkdownDescription: "Query information about an existing CoreWeave Kubernetes Service (CKS) cluster by ID, or by name and zone. See the [CKS API reference](https://docs.coreweave.com/products/cks/reference/cks-api).",
+		Attributes:          attributes,
+	}
+}
+
+func (d *ClusterDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+// clusterDataSourceAttributes returns the attributes of a cluster other than id, which is required by the data source
+// of a single cluster but computed for the items of the data source that lists them.
+func clusterDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"vpc_id": schema.StringAttribute{
+			MarkdownDescription: "The VPC ID of the cluster.",
+			Computed:            true,
+		},
+		"zone": schema.StringAttribute{
+			MarkdownDescription: "The zone of the cluster.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the cluster.",
+			Computed:            true,
+		},
+		"version": schema.StringAttribute{
+			MarkdownDescription: "The version of the cluster.",
+			Computed:            true,
+		},
+		"public": schema.BoolAttribute{
+			MarkdownDescription: "Whether the cluster is public.",
+			Computed:            true,
+		},
+		"pod_cidr_name": schema.StringAttribute{
+			MarkdownDescription: "The pod CIDR name of the cluster.",
+			Computed:            true,
+		},
+		"service_cidr_name": schema.StringAttribute{
+			MarkdownDescription: "The service CIDR name of the cluster.",
+			Computed:            true,
+		},
+		"internal_lb_cidr_names": schema.ListAttribute{
+			MarkdownDescription: "The internal load balancer CIDR names of the cluster.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		// v6 fields
+		"pod_cidr_name_v6": schema.StringAttribute{
+			MarkdownDescription: "The IPv6 pod CIDR name of the cluster.",
+			Computed:            true,
+		},
+		"service_cidr_name_v6": schema.StringAttribute{
+			MarkdownDescription: "The IPv6 service CIDR name of the cluster.",
+			Computed:            true,
+		},
+		"internal_lb_cidr_names_v6": schema.ListAttribute{
+			MarkdownDescription: "The IPv6 internal load balancer CIDR names of the cluster.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"node_port_range": schema.SingleNestedAttribute{
+			MarkdownDescription: "The Kubernetes Service NodePort range.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"start": schema.Int32Attribute{
+					MarkdownDescription: "Start of the NodePort range.",
+					Computed:            true,
 				},
-			},
-			"audit_policy": schema.StringAttribute{
-				MarkdownDescription: "The audit policy of the cluster.",
-				Computed:            true,
-			},
-			"oidc": schema.SingleNestedAttribute{
-				MarkdownDescription: "The OIDC configuration of the cluster.",
-				Computed:            true,
-				Attributes: map[string]schema.Attribute{
-					"issuer_url": schema.StringAttribute{
-						MarkdownDescription: "The issuer URL of the OIDC configuration.",
-						Computed:            true,
-					},
-					"client_id": schema.StringAttribute{
-						MarkdownDescription: "The client ID of the OIDC configuration.",
-						Computed:            true,
-					},
+				"end": schema.Int32Attribute{
+					MarkdownDescription: "End of the NodePort range.",
+					Computed:            true,
 				},
 			},
-			"authn_webhook": schema.SingleNestedAttribute{
-				MarkdownDescription: "The authentication webhook configuration of the cluster.",
-				Computed:            true,
-				Attributes: map[string]schema.Attribute{
-					"server": schema.StringAttribute{
-						MarkdownDescription: "The server URL of the authentication webhook.",
-						Computed:            true,
-					},
-					"ca": schema.StringAttribute{
-						MarkdownDescription: "The CA certificate of the authentication webhook.",
-						Computed:            true,
-					},
+		},
+		"audit_policy": schema.StringAttribute{
+			MarkdownDescription: "The audit policy of the cluster.",
+			Computed:            true,
+		},
+		"oidc": schema.SingleNestedAttribute{
+			MarkdownDescription: "The OIDC configuration of the cluster.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"issuer_url": schema.StringAttribute{
+					MarkdownDescription: "The issuer URL of the OIDC configuration.",
+					Computed:            true,
 				},
-			},
-			"authz_webhook": schema.SingleNestedAttribute{
-				MarkdownDescription: "The authorization webhook configuration of the cluster.",
-				Computed:            true,
-				Attributes: map[string]schema.Attribute{
-					"server": schema.StringAttribute{
-						MarkdownDescription: "The server URL of the authorization webhook.",
-						Computed:            true,
-					},
-					"ca": schema.StringAttribute{
-						MarkdownDescription: "The CA certificate of the authorization webhook.",
-						Computed:            true,
-					},
+				"client_id": schema.StringAttribute{
+					MarkdownDescription: "The client ID of the OIDC configuration.",
+					Computed:            true,
 				},
 			},
-			"api_server_endpoint": schema.StringAttribute{
-				MarkdownDescription: "The API server endpoint of the cluster.",
-				Computed:            true,
-			},
-			"status": schema.StringAttribute{
-				MarkdownDescription: "The status of the cluster.",
-				Computed:            true,
-			},
-			"service_account_oidc_issuer_url": schema.StringAttribute{
-				MarkdownDescription: "The URL of the OIDC issuer for the cluster's service account tokens. This value corresponds to the `--service-account-issuer` flag on the kube-apiserver.",
-				Computed:            true,
-			},
-			"shared_storage_cluster_id": schema.StringAttribute{
-				MarkdownDescription: "The `cluster_id` of the cluster to share storage with. Must be enabled by CoreWeave support. Contact CoreWeave support if you are interested in this feature.",
-				Computed:            true,
-			},
-			"additional_server_sans": schema.SetAttribute{
-				MarkdownDescription: "Additional Subject Alternative Names (SANs) included in the Kubernetes API server TLS certificate.",
-				Computed:            true,
-				ElementType:         types.StringType,
+		},
+		"authn_webhook": schema.SingleNestedAttribute{
+			MarkdownDescription: "The authentication webhook configuration of the cluster.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"server": schema.StringAttribute{
+					MarkdownDescription: "The server URL of the authentication webhook.",
+					Computed:            true,
+				},
+				"ca": schema.StringAttribute{
+					MarkdownDescription: "The CA certificate of the authentication webhook.",
+					Computed:            true,
+				},
 			},
-			"tailscale": schema.SingleNestedAttribute{
-				MarkdownDescription: "Tailscale configuration for the cluster. Enables cluster access over a Tailscale VPN.",
-				Computed:            true,
-				Attributes: map[string]schema.Attribute{
-					"client_id": schema.StringAttribute{
-						MarkdownDescription: "The Tailscale Client ID for the federated identity.",
-						Computed:            true,
-					},
+		},
+		"authz_webhook": schema.SingleNestedAttribute{
+			MarkdownDescription: "The authorization webhook configuration of the cluster.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"server": schema.StringAttribute{
+					MarkdownDescription: "The server URL of the authorization webhook.",
+					Computed:            true,
+				},
+				"ca": schema.StringAttribute{
+					MarkdownDescription: "The CA certificate of the authorization webhook.",
+					Computed:            true,
 				},
 			},
-			"kubelet": schema.StringAttribute{
-				CustomType:          jsontypes.NormalizedType{},
-				MarkdownDescription: "Selective overrides applied to every cluster Node's kubelet configuration, as a JSON object.",
-				Computed:            true,
+		},
+		"api_server_endpoint": schema.StringAttribute{
+			MarkdownDescription: "The API server endpoint of the cluster.",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "The status of the cluster.",
+			Computed:            true,
+		},
+		"service_account_oidc_issuer_url": schema.StringAttribute{
+			MarkdownDescription: "The URL of the OIDC issuer for the cluster's service account tokens. This value corresponds to the `--service-account-issuer` flag on the kube-apiserver.",
+			Computed:            true,
+		},
+		"shared_storage_cluster_id": schema.StringAttribute{
+			MarkdownDescription: "The `cluster_id` of the cluster to share storage with. Must be enabled by CoreWeave support. Contact CoreWeave support if you are interested in this feature.",
+			Computed:            true,
+		},
+		"additional_server_sans": schema.SetAttribute{
+			MarkdownDescription: "Additional Subject Alternative Names (SANs) included in the Kubernetes API server TLS certificate.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"tailscale": schema.SingleNestedAttribute{
+			MarkdownDescription: "Tailscale configuration for the cluster. Enables cluster access over a Tailscale VPN.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"client_id": schema.StringAttribute{
+					MarkdownDescription: "The Tailscale Client ID for the federated identity.",
+					Computed:            true,
+				},
 			},
 		},
+		"kubelet": schema.StringAttribute{
+			CustomType:          jsontypes.NormalizedType{},
+			MarkdownDescription: "Selective overrides applied to every cluster Node's kubelet configuration, as a JSON object.",
+			Computed:            true,
+		},
 	}
 }
 
@@ -216,17 +250,40 @@ func (d *ClusterDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	cluster, err := d.client.GetCluster(ctx, connect.NewRequest(&cksv1beta1.GetClusterRequest{
-		Id: data.Id.ValueString(),
-	}))
-	if err != nil {
-		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+	var cluster *cksv1beta1.Cluster
+	if !data.Id.IsNull() {
+		getResp, err := d.client.GetCluster(ctx, connect.NewRequest(&cksv1beta1.GetClusterRequest{
+			Id: data.Id.ValueString(),
+		}))
+		if err != nil {
+			coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+			return
+		}
+		cluster = getResp.Msg.Cluster
+	} else if cluster = d.findByName(ctx, data, &resp.Diagnostics); cluster == nil {
 		return
 	}
-	data.Set(cluster.Msg.Cluster)
+
+	data.Set(cluster)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
+// findByName returns the cluster with the name, and zone if set, of data. It adds an error and returns nil if there is
+// no such cluster, or more than one.
+func (d *ClusterDataSource) findByName(ctx context.Context, data *ClusterDataSourceModel, diagnostics *diag.Diagnostics) *cksv1beta1.Cluster {
+	listResp, err := d.client.ListClusters(ctx, connect.NewRequest(&cksv1beta1.ListClustersRequest{}))
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, diagnostics)
+		return nil
+	}
+
+	cluster, _ := coreweave.FindByName(diagnostics, "cluster", data.Name.ValueString(), data.Zone, listResp.Msg.Items,
+		func(cluster *cksv1beta1.Cluster) string { return cluster.Name },
+		func(cluster *cksv1beta1.Cluster) string { return cluster.Zone },
+	)
+	return cluster
+}
+
 func MustRenderClusterDataSource(_ context.Context, resourceName string, cluster *ClusterDataSourceModel) string {
 	file := hclwrite.NewEmptyFile()
 	body := file.Body()
@@ -234,7 +291,14 @@ func MustRenderClusterDataSource(_ context.Context, resourceName string, cluster
 	resource := body.AppendNewBlock("data", []string{"coreweave_cks_cluster", resourceName})
 	resourceBody := resource.Body()
 
-	resourceBody.SetAttributeRaw("id", hclwrite.Tokens{{Type: hclsyntax.TokenIdent, Bytes: []byte(cluster.Id.ValueString())}})
+	if !cluster.Id.IsNull() {
+		resourceBody.SetAttributeRaw("id", hclwrite.Tokens{{Type: hclsyntax.TokenIdent, Bytes: []byte(cluster.Id.ValueString())}})
+	} else {
+		resourceBody.SetAttributeRaw("name", hclwrite.Tokens{{Type: hclsyntax.TokenIdent, Bytes: []byte(cluster.Name.ValueString())}})
+		if !cluster.Zone.IsNull() {
+			resourceBody.SetAttributeRaw("zone", hclwrite.Tokens{{Type: hclsyntax.TokenIdent, Bytes: []byte(cluster.Zone.ValueString())}})
+		}
+	}
 
 	var buf bytes.Buffer
 	if _, err := file.WriteTo(&buf); err != nil {
@@ -0,0 +1,68 @@
+package cks_test
+
+import (
+	"testing"
+
+	cksv1beta1 "buf.build/gen/go/coreweave/cks/protocolbuffers/go/coreweave/cks/v1beta1"
+	networkingv1beta1 "buf.build/gen/go/coreweave/networking/protocolbuffers/go/coreweave/networking/v1beta1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave/cks"
+	"github.com/coreweave/terraform-provider-coreweave/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterDataSource_ReadByName(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.NewFakeClient(t)
+	ids := map[string]string{}
+	for _, cluster := range []struct{ name, zone string }{
+		{"shared", "US-EAST-04A"},
+		{"shared", "US-WEST-01A"},
+		{"unique", "US-EAST-04A"},
+	} {
+		vpc, err := client.CreateVPC(t.Context(), connect.NewRequest(&networkingv1beta1.CreateVPCRequest{Name: cluster.name, Zone: cluster.zone}))
+		require.NoError(t, err)
+		created, err := client.CreateCluster(t.Context(), connect.NewRequest(&cksv1beta1.CreateClusterRequest{
+			Name:  cluster.name,
+			Zone:  cluster.zone,
+			VpcId: vpc.Msg.GetVpc().GetId(),
+		}))
+		require.NoError(t, err)
+		ids[cluster.name+"/"+cluster.zone] = created.Msg.GetCluster().GetId()
+	}
+
+	tests := []struct {
+		name        string
+		config      map[string]any
+		want        string
+		wantSummary string
+	}{
+		{name: "by id", config: map[string]any{"id": ids["shared/US-WEST-01A"]}, want: "shared/US-WEST-01A"},
+		{name: "by unique name", config: map[string]any{"name": "unique"}, want: "unique/US-EAST-04A"},
+		{name: "by name and zone", config: map[string]any{"name": "shared", "zone": "US-WEST-01A"}, want: "shared/US-WEST-01A"},
+		{name: "ambiguous name", config: map[string]any{"name": "shared"}, wantSummary: "Ambiguous Cluster Name"},
+		{name: "missing name", config: map[string]any{"name": "missing"}, wantSummary: "Cluster Not Found"},
+		{name: "name in another zone", config: map[string]any{"name": "unique", "zone": "US-WEST-01A"}, wantSummary: "Cluster Not Found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state, diagnostics := testutil.ReadDataSource(t, cks.NewClusterDataSource(), client, tt.config)
+			if tt.wantSummary != "" {
+				require.True(t, diagnostics.HasError())
+				assert.Equal(t, tt.wantSummary, diagnostics[0].Summary())
+				return
+			}
+			require.False(t, diagnostics.HasError(), diagnostics)
+
+			var data cks.ClusterDataSourceModel
+			require.False(t, state.Get(t.Context(), &data).HasError())
+			assert.Equal(t, tt.want, data.Name.ValueString()+"/"+data.Zone.ValueString())
+			assert.Equal(t, ids[tt.want], data.Id.ValueString())
+		})
+	}
+}
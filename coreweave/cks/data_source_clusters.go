@@ -0,0 +1,132 @@
+package cks
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	cksv1beta1 "buf.build/gen/go/coreweave/cks/protocolbuffers/go/coreweave/cks/v1beta1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &ClustersDataSource{}
+)
+
+func NewClustersDataSource() datasource.DataSource {
+	return &ClustersDataSource{}
+}
+
+type ClustersDataSource struct {
+	client *coreweave.Client
+}
+
+type ClustersDataSourceModel struct {
+	Zone      types.String             `tfsdk:"zone"`
+	NameRegex types.String             `tfsdk:"name_regex"`
+	Status    types.String             `tfsdk:"status"`
+	Clusters  []ClusterDataSourceModel `tfsdk:"clusters"`
+}
+
+// Metadata implements datasource.DataSource.
+func (d *ClustersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cks_clusters"
+}
+
+func (d *ClustersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	clusterAttributes := clusterDataSourceAttributes()
+	clusterAttributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The ID of the cluster.",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the existing CoreWeave Kubernetes Service (CKS) clusters, optionally filtered by zone, name and status. See the [CKS API reference](https://docs.coreweave.com/products/cks/reference/cks-api).",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "Only list the clusters in this zone.",
+				Optional:            true,
+			},
+			"name_regex": coreweave.NameRegexAttribute("clusters"),
+			"status": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Only list the clusters with this status. Must be one of %s.", coreweave.EnumMarkdownValues(cksv1beta1.Cluster_Status_name, true)),
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(coreweave.EnumValues(cksv1beta1.Cluster_Status_name, true)...),
+				},
+			},
+			"clusters": schema.ListNestedAttribute{
+				MarkdownDescription: "The clusters that match the filters, sorted by name and zone.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: clusterAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *ClustersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*coreweave.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *coreweave.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ClustersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	data := new(ClustersDataSourceModel)
+	resp.Diagnostics.Append(req.Config.Get(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegex := coreweave.CompileNameRegex(data.NameRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ListClusters is not paginated: a single response holds every cluster of the organization.
+	listResp, err := d.client.ListClusters(ctx, connect.NewRequest(&cksv1beta1.ListClustersRequest{}))
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+		return
+	}
+
+	clusters := slices.DeleteFunc(slices.Clone(listResp.Msg.Items), func(cluster *cksv1beta1.Cluster) bool {
+		return !coreweave.MatchesFilters(nameRegex, data.Zone, data.Status, cluster.Name, cluster.Zone, cluster.Status.String())
+	})
+	slices.SortFunc(clusters, func(a, b *cksv1beta1.Cluster) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Zone, b.Zone), cmp.Compare(a.Id, b.Id))
+	})
+
+	data.Clusters = make([]ClusterDataSourceModel, len(clusters))
+	for i, cluster := range clusters {
+		// Unlike the configuration of a single cluster, which Set fills in, a listed cluster starts out empty, so its
+		// lists must be typed for the attributes that Set leaves untouched.
+		data.Clusters[i] = ClusterDataSourceModel{
+			InternalLBCidrNames:   types.ListNull(types.StringType),
+			InternalLBCidrNamesV6: types.ListNull(types.StringType),
+		}
+		data.Clusters[i].Set(cluster)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
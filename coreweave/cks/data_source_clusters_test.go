@@ -0,0 +1,84 @@
+package cks_test
+
+import (
+	"testing"
+
+	cksv1beta1 "buf.build/gen/go/coreweave/cks/protocolbuffers/go/coreweave/cks/v1beta1"
+	networkingv1beta1 "buf.build/gen/go/coreweave/networking/protocolbuffers/go/coreweave/networking/v1beta1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave/cks"
+	"github.com/coreweave/terraform-provider-coreweave/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClustersDataSource_Read(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.NewFakeClient(t)
+	for _, zone := range []string{"US-EAST-04A", "US-WEST-01A"} {
+		vpc, err := client.CreateVPC(t.Context(), connect.NewRequest(&networkingv1beta1.CreateVPCRequest{Name: "default", Zone: zone}))
+		require.NoError(t, err)
+		for _, name := range []string{"prod", "dev"} {
+			_, err := client.CreateCluster(t.Context(), connect.NewRequest(&cksv1beta1.CreateClusterRequest{
+				Name:  name + "-" + zone,
+				Zone:  zone,
+				VpcId: vpc.Msg.GetVpc().GetId(),
+			}))
+			require.NoError(t, err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		config map[string]any
+		want   []string
+	}{
+		{
+			name: "all clusters, sorted by name",
+			want: []string{"dev-US-EAST-04A", "dev-US-WEST-01A", "prod-US-EAST-04A", "prod-US-WEST-01A"},
+		},
+		{
+			name:   "by zone",
+			config: map[string]any{"zone": "US-WEST-01A"},
+			want:   []string{"dev-US-WEST-01A", "prod-US-WEST-01A"},
+		},
+		{
+			name:   "by name regex and status",
+			config: map[string]any{"name_regex": "^prod-", "status": cksv1beta1.Cluster_STATUS_RUNNING.String()},
+			want:   []string{"prod-US-EAST-04A", "prod-US-WEST-01A"},
+		},
+		{
+			name:   "no match",
+			config: map[string]any{"status": cksv1beta1.Cluster_STATUS_FAILED.String()},
+			want:   []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state, diagnostics := testutil.ReadDataSource(t, cks.NewClustersDataSource(), client, tt.config)
+			require.False(t, diagnostics.HasError(), diagnostics)
+
+			var data cks.ClustersDataSourceModel
+			require.False(t, state.Get(t.Context(), &data).HasError())
+
+			names := make([]string, len(data.Clusters))
+			for i, cluster := range data.Clusters {
+				names[i] = cluster.Name.ValueString()
+				assert.NotEmpty(t, cluster.Id.ValueString())
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
+func TestClustersDataSource_InvalidNameRegex(t *testing.T) {
+	t.Parallel()
+
+	_, diagnostics := testutil.ReadDataSource(t, cks.NewClustersDataSource(), testutil.NewFakeClient(t), map[string]any{"name_regex": "("})
+	require.True(t, diagnostics.HasError())
+	assert.Equal(t, "Invalid Regular Expression", diagnostics[0].Summary())
+}
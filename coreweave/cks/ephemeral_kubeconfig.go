@@ -0,0 +1,324 @@
+package cks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	cksv1beta1 "buf.build/gen/go/coreweave/cks/protocolbuffers/go/coreweave/cks/v1beta1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+const defaultExecAPIVersion = "client.authentication.k8s.io/v1"
+
+var (
+	_ ephemeral.EphemeralResource                     = &KubeconfigEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure        = &KubeconfigEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigValidators = &KubeconfigEphemeralResource{}
+)
+
+func NewKubeconfigEphemeralResource() ephemeral.EphemeralResource {
+	return &KubeconfigEphemeralResource{}
+}
+
+// KubeconfigEphemeralResource renders a kubeconfig for a CKS cluster. Its values are never persisted to state.
+type KubeconfigEphemeralResource struct {
+	client *coreweave.Client
+}
+
+type KubeconfigEphemeralResourceModel struct {
+	ClusterId   types.String    `tfsdk:"cluster_id"`
+	Exec        *KubeconfigExec `tfsdk:"exec"`
+	UseAPIToken types.Bool      `tfsdk:"use_api_token"`
+	ClusterName types.String    `tfsdk:"cluster_name"`
+	Server      types.String    `tfsdk:"server"`
+	Token       types.String    `tfsdk:"token"`
+	Kubeconfig  types.String    `tfsdk:"kubeconfig_raw"`
+}
+
+// KubeconfigExec configures a client-go credential plugin in place of a static token.
+type KubeconfigExec struct {
+	APIVersion types.String `tfsdk:"api_version"`
+	Command    types.String `tfsdk:"command"`
+	Args       types.List   `tfsdk:"args"`
+	Env        types.Map    `tfsdk:"env"`
+}
+
+func (e *KubeconfigEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cks_kubeconfig"
+}
+
+func (e *KubeconfigEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Renders a kubeconfig for a CoreWeave Kubernetes Service (CKS) cluster. The values are never persisted to state or plan, so they can be used to configure the `kubernetes` and `helm` providers in the same run that creates the cluster. Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the cluster.",
+			},
+			"exec": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "A [client-go credential plugin](https://kubernetes.io/docs/reference/access-authn-authz/authentication/#client-go-credential-plugins) to obtain credentials with. Use this to issue short-lived credentials. Exactly one of `exec` or `use_api_token` must be set.",
+				Attributes: map[string]schema.Attribute{
+					"api_version": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: fmt.Sprintf("The API version of the `ExecCredential` the plugin returns. Defaults to `%s`.", defaultExecAPIVersion),
+					},
+					"command": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The command to execute.",
+					},
+					"args": schema.ListAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Arguments to pass to the command.",
+					},
+					"env": schema.MapAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Environment variables to set for the command.",
+					},
+				},
+			},
+			"use_api_token": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Must be `true` to authenticate to the api-server with the provider's API token, which is embedded in the kubeconfig and returned as `token`. This is the provider's long-lived API token with its full scope, not a credential limited to the cluster: anyone who can read the kubeconfig can use it to call the CoreWeave API on your behalf. Exactly one of `exec` or `use_api_token` must be set.",
+				Validators: []validator.Bool{
+					boolvalidator.Equals(true),
+				},
+			},
+			"cluster_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the cluster, which is also used as the name of the cluster, user and context in the kubeconfig.",
+			},
+			"server": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL of the cluster's api-server. Its certificate is signed by a publicly trusted CA, so no CA certificate is needed to connect.",
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The bearer token to authenticate to the api-server with, which is the provider's full-scope API token. Null unless `use_api_token` is set.",
+			},
+			"kubeconfig_raw": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The kubeconfig, in YAML format.",
+			},
+		},
+	}
+}
+
+func (e *KubeconfigEphemeralResource) ConfigValidators(_ context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.ExactlyOneOf(path.MatchRoot("exec"), path.MatchRoot("use_api_token")),
+	}
+}
+
+func (e *KubeconfigEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*coreweave.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *coreweave.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	e.client = client
+}
+
+func (e *KubeconfigEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data KubeconfigEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, err := e.client.GetCluster(ctx, connect.NewRequest(&cksv1beta1.GetClusterRequest{
+		Id: data.ClusterId.ValueString(),
+	}))
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+		return
+	}
+
+	if cluster.Msg.Cluster.ApiServerEndpoint == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cluster_id"),
+			"Cluster Not Ready",
+			fmt.Sprintf("Cluster '%s' does not have an api-server endpoint yet, its status is %s.", data.ClusterId.ValueString(), cluster.Msg.Cluster.Status.String()),
+		)
+		return
+	}
+
+	name := cluster.Msg.Cluster.Name
+	if name == "" {
+		name = cluster.Msg.Cluster.Id
+	}
+	server := apiServerURL(cluster.Msg.Cluster.ApiServerEndpoint)
+
+	user := kubeconfigUser{}
+	data.Token = types.StringNull()
+	if data.Exec != nil {
+		exec, diags := data.Exec.render(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		user.Exec = exec
+	} else {
+		// the provider can be configured with static S3 credentials alone, in which case it has no API token
+		if e.client.Token == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("use_api_token"),
+				"Missing API Token",
+				"The provider is not configured with an API token to embed in the kubeconfig. Configure the provider's token, or set exec to obtain credentials from a credential plugin.",
+			)
+			return
+		}
+		user.Token = e.client.Token
+		data.Token = types.StringValue(e.client.Token)
+	}
+
+	kubeconfig, err := renderKubeconfig(name, server, user)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Render Kubeconfig", err.Error())
+		return
+	}
+
+	data.ClusterName = types.StringValue(name)
+	data.Server = types.StringValue(server)
+	data.Kubeconfig = types.StringValue(kubeconfig)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// apiServerURL returns the api-server endpoint as an https URL. The API reports it without a scheme.
+func apiServerURL(endpoint string) string {
+	if strings.Contains(endpoint, "://") {
+		return endpoint
+	}
+	return "https://" + endpoint
+}
+
+// kubeconfig mirrors the subset of the clientcmd v1 Config that we render.
+type kubeconfig struct {
+	APIVersion     string              `yaml:"apiVersion"`
+	Kind           string              `yaml:"kind"`
+	Clusters       []kubeconfigCluster `yaml:"clusters"`
+	Contexts       []kubeconfigContext `yaml:"contexts"`
+	CurrentContext string              `yaml:"current-context"`
+	Users          []kubeconfigAuth    `yaml:"users"`
+}
+
+type kubeconfigCluster struct {
+	Name    string `yaml:"name"`
+	Cluster struct {
+		Server string `yaml:"server"`
+	} `yaml:"cluster"`
+}
+
+type kubeconfigContext struct {
+	Name    string `yaml:"name"`
+	Context struct {
+		Cluster string `yaml:"cluster"`
+		User    string `yaml:"user"`
+	} `yaml:"context"`
+}
+
+type kubeconfigAuth struct {
+	Name string         `yaml:"name"`
+	User kubeconfigUser `yaml:"user"`
+}
+
+type kubeconfigUser struct {
+	Token string          `yaml:"token,omitempty"`
+	Exec  *kubeconfigExec `yaml:"exec,omitempty"`
+}
+
+type kubeconfigExec struct {
+	APIVersion      string             `yaml:"apiVersion"`
+	Command         string             `yaml:"command"`
+	Args            []string           `yaml:"args,omitempty"`
+	Env             []kubeconfigEnvVar `yaml:"env,omitempty"`
+	InteractiveMode string             `yaml:"interactiveMode"`
+}
+
+type kubeconfigEnvVar struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
+}
+
+func (e *KubeconfigExec) render(ctx context.Context) (*kubeconfigExec, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	exec := &kubeconfigExec{
+		APIVersion: defaultExecAPIVersion,
+		Command:    e.Command.ValueString(),
+		// Terraform has no terminal to prompt on
+		InteractiveMode: "Never",
+	}
+	if !e.APIVersion.IsNull() {
+		exec.APIVersion = e.APIVersion.ValueString()
+	}
+
+	diags.Append(e.Args.ElementsAs(ctx, &exec.Args, false)...)
+
+	env := map[string]string{}
+	diags.Append(e.Env.ElementsAs(ctx, &env, false)...)
+	for _, name := range slices.Sorted(maps.Keys(env)) {
+		exec.Env = append(exec.Env, kubeconfigEnvVar{Name: name, Value: env[name]})
+	}
+
+	return exec, diags
+}
+
+// renderKubeconfig renders a kubeconfig with a single cluster, user and context, all named name.
+func renderKubeconfig(name, server string, user kubeconfigUser) (string, error) {
+	config := kubeconfig{
+		APIVersion:     "v1",
+		Kind:           "Config",
+		CurrentContext: name,
+		Users:          []kubeconfigAuth{{Name: name, User: user}},
+	}
+
+	cluster := kubeconfigCluster{Name: name}
+	cluster.Cluster.Server = server
+	config.Clusters = []kubeconfigCluster{cluster}
+
+	kubeContext := kubeconfigContext{Name: name}
+	kubeContext.Context.Cluster = name
+	kubeContext.Context.User = name
+	config.Contexts = []kubeconfigContext{kubeContext}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(config); err != nil {
+		return "", err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
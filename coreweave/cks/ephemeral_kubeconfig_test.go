@@ -0,0 +1,149 @@
+package cks
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"buf.build/gen/go/coreweave/cks/connectrpc/go/coreweave/cks/v1beta1/cksv1beta1connect"
+	cksv1beta1 "buf.build/gen/go/coreweave/cks/protocolbuffers/go/coreweave/cks/v1beta1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderKubeconfig_Token(t *testing.T) {
+	kubeconfig, err := renderKubeconfig("my-cluster", "https://my-cluster.k8s.example.com", kubeconfigUser{Token: "CW-SECRET-test"})
+	require.NoError(t, err)
+
+	assert.Equal(t, `apiVersion: v1
+kind: Config
+clusters:
+  - name: my-cluster
+    cluster:
+      server: https://my-cluster.k8s.example.com
+contexts:
+  - name: my-cluster
+    context:
+      cluster: my-cluster
+      user: my-cluster
+current-context: my-cluster
+users:
+  - name: my-cluster
+    user:
+      token: CW-SECRET-test
+`, kubeconfig)
+}
+
+func TestRenderKubeconfig_Exec(t *testing.T) {
+	exec := &KubeconfigExec{
+		APIVersion: types.StringNull(),
+		Command:    types.StringValue("cw-credentials"),
+		Args:       types.ListValueMust(types.StringType, []attr.Value{types.StringValue("token"), types.StringValue("--ttl=1h")}),
+		Env: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"PROFILE": types.StringValue("ci"),
+			"DEBUG":   types.StringValue("false"),
+		}),
+	}
+
+	rendered, diags := exec.render(t.Context())
+	require.False(t, diags.HasError(), diags)
+
+	kubeconfig, err := renderKubeconfig("my-cluster", "https://my-cluster.k8s.example.com", kubeconfigUser{Exec: rendered})
+	require.NoError(t, err)
+
+	assert.Contains(t, kubeconfig, `users:
+  - name: my-cluster
+    user:
+      exec:
+        apiVersion: client.authentication.k8s.io/v1
+        command: cw-credentials
+        args:
+          - token
+          - --ttl=1h
+        env:
+          - name: DEBUG
+            value: "false"
+          - name: PROFILE
+            value: ci
+        interactiveMode: Never
+`)
+	assert.NotContains(t, kubeconfig, "token:")
+}
+
+func TestAPIServerURL(t *testing.T) {
+	assert.Equal(t, "https://my-cluster.k8s.example.com", apiServerURL("my-cluster.k8s.example.com"))
+	assert.Equal(t, "https://my-cluster.k8s.example.com:6443", apiServerURL("https://my-cluster.k8s.example.com:6443"))
+}
+
+// runningClusterService serves a single RUNNING cluster.
+type runningClusterService struct {
+	cksv1beta1connect.UnimplementedClusterServiceHandler
+}
+
+func (runningClusterService) GetCluster(_ context.Context, req *connect.Request[cksv1beta1.GetClusterRequest]) (*connect.Response[cksv1beta1.GetClusterResponse], error) {
+	return connect.NewResponse(&cksv1beta1.GetClusterResponse{Cluster: &cksv1beta1.Cluster{
+		Id:                req.Msg.GetId(),
+		Name:              "my-cluster",
+		Status:            cksv1beta1.Cluster_STATUS_RUNNING,
+		ApiServerEndpoint: "my-cluster.k8s.example.com",
+	}}), nil
+}
+
+func TestKubeconfigEphemeralResource_OpenWithAPIToken(t *testing.T) {
+	_, handler := cksv1beta1connect.NewClusterServiceHandler(runningClusterService{})
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr string
+	}{
+		{name: "embeds the API token", token: "CW-SECRET-test"},
+		{name: "fails without an API token", wantErr: "Missing API Token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+
+			client, err := coreweave.NewClient(server.URL, server.URL, 10*time.Second, coreweave.RetryConfig{}, coreweave.TransportConfig{})
+			require.NoError(t, err)
+			client.Token = tt.token
+			e := &KubeconfigEphemeralResource{client: client}
+
+			var schemaResp ephemeral.SchemaResponse
+			e.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			values := map[string]tftypes.Value{}
+			for name, attrType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attrType, nil)
+			}
+			values["cluster_id"] = tftypes.NewValue(tftypes.String, "my-cluster-id")
+			values["use_api_token"] = tftypes.NewValue(tftypes.Bool, true)
+
+			req := ephemeral.OpenRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}}
+			resp := ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+			e.Open(ctx, req, &resp)
+
+			if tt.wantErr != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Equal(t, tt.wantErr, resp.Diagnostics.Errors()[0].Summary())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			var got KubeconfigEphemeralResourceModel
+			require.False(t, resp.Result.Get(ctx, &got).HasError())
+			assert.Equal(t, tt.token, got.Token.ValueString())
+			assert.Contains(t, got.Kubeconfig.ValueString(), "token: "+tt.token)
+		})
+	}
+}
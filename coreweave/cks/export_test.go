@@ -0,0 +1,6 @@
+package cks
+
+// exported for testing
+
+// ClusterResourceStateModel is the state of the cluster resource.
+type ClusterResourceStateModel = clusterResourceStateModel
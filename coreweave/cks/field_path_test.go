@@ -0,0 +1,33 @@
+package cks
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestClusterFieldPath(t *testing.T) {
+	tests := map[string]path.Path{
+		"name":                                       path.Root("name"),
+		"network.pod_cidr_name":                      path.Root("pod_cidr_name"),
+		"network.internal_lb_cidr_names[0]":          path.Root("internal_lb_cidr_names").AtListIndex(0),
+		"network.service_node_port_range.start":      path.Root("node_port_range").AtName("start"),
+		"kubelet.max_pods":                           path.Root("kubelet"),
+		"oidc.issuer_url":                            path.Root("oidc").AtName("issuer_url"),
+		"spec.network.pod_cidr_name":                 path.Root("pod_cidr_name"),
+		"spec.network.service_node_port_range.start": path.Root("node_port_range").AtName("start"),
+		"spec.kubelet":                               path.Root("kubelet"),
+	}
+
+	for field, want := range tests {
+		t.Run(field, func(t *testing.T) {
+			got, ok := clusterFieldPath(field)
+			if !ok {
+				t.Fatalf("clusterFieldPath(%q) returned no path", field)
+			}
+			if !got.Equal(want) {
+				t.Errorf("clusterFieldPath(%q) = %s, want %s", field, got, want)
+			}
+		})
+	}
+}
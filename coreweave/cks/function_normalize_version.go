@@ -0,0 +1,77 @@
+package cks
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// kubernetesVersionPattern matches Kubernetes versions with an optional `v` prefix, patch version, and pre-release or
+// build suffix, e.g. `1.35`, `v1.35.2` or `v1.35.2-cw.1`.
+var kubernetesVersionPattern = regexp.MustCompile(`^[vV]?(\d+)\.(\d+)(?:\.\d+)?(?:[-+][0-9A-Za-z.\-+]*)?$`)
+
+var _ function.Function = &NormalizeVersionFunction{}
+
+func NewNormalizeVersionFunction() function.Function {
+	return &NormalizeVersionFunction{}
+}
+
+// NormalizeVersionFunction converts a Kubernetes version into the minor version format used by the cluster `version`
+// attribute.
+type NormalizeVersionFunction struct{}
+
+func (f *NormalizeVersionFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_cks_version"
+}
+
+func (f *NormalizeVersionFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Normalize a CKS Kubernetes version",
+		MarkdownDescription: "Converts a Kubernetes version such as `1.35`, `v1.35.2` or `v1.35.2-cw.1` into the minor version format expected by the `version` attribute of `coreweave_cks_cluster`, e.g. `v1.35`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "version",
+				MarkdownDescription: "The Kubernetes version.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NormalizeVersionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var version string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &version))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := normalizeVersion(version)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalized))
+}
+
+// normalizeVersion converts a Kubernetes version into the `v<major>.<minor>` format used by CKS.
+func normalizeVersion(version string) (string, error) {
+	match := kubernetesVersionPattern.FindStringSubmatch(version)
+	if match == nil {
+		return "", fmt.Errorf("%q is not a valid Kubernetes version, expected a version such as v1.35 or 1.35.2", version)
+	}
+
+	major, err := strconv.Atoi(match[1])
+	if err != nil {
+		return "", fmt.Errorf("invalid major version in %q: %w", version, err)
+	}
+	minor, err := strconv.Atoi(match[2])
+	if err != nil {
+		return "", fmt.Errorf("invalid minor version in %q: %w", version, err)
+	}
+
+	return fmt.Sprintf("v%d.%d", major, minor), nil
+}
@@ -0,0 +1,45 @@
+package cks
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeVersionFunction(t *testing.T) {
+	tests := map[string]struct {
+		version string
+		want    string
+		wantErr bool
+	}{
+		"minor":                  {version: "v1.35", want: "v1.35"},
+		"without prefix":         {version: "1.35", want: "v1.35"},
+		"patch":                  {version: "v1.35.2", want: "v1.35"},
+		"uppercase prefix":       {version: "V1.34.0", want: "v1.34"},
+		"pre-release and build":  {version: "1.35.2-cw.1+build.7", want: "v1.35"},
+		"leading zeros":          {version: "v1.035", want: "v1.35"},
+		"major only":             {version: "v1", wantErr: true},
+		"empty":                  {version: "", wantErr: true},
+		"surrounding whitespace": {version: " v1.35 ", wantErr: true},
+		"not a version":          {version: "latest", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+			NewNormalizeVersionFunction().Run(t.Context(), function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.version)}),
+			}, resp)
+
+			if tt.wantErr {
+				assert.NotNil(t, resp.Error)
+				return
+			}
+			assert.Nil(t, resp.Error)
+			assert.Equal(t, types.StringValue(tt.want), resp.Result.Value())
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package cks
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	cksv1beta1 "buf.build/gen/go/coreweave/cks/protocolbuffers/go/coreweave/cks/v1beta1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &ClusterListResource{}
+	_ list.ListResourceWithConfigure = &ClusterListResource{}
+)
+
+func NewClusterListResource() list.ListResource {
+	return &ClusterListResource{}
+}
+
+// ClusterListResource lists the existing clusters for `terraform query`, so that they can be imported.
+type ClusterListResource struct {
+	client *coreweave.Client
+}
+
+type ClusterListResourceModel struct {
+	Zone      types.String `tfsdk:"zone"`
+	NameRegex types.String `tfsdk:"name_regex"`
+}
+
+func (l *ClusterListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cks_cluster"
+}
+
+func (l *ClusterListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the existing CoreWeave Kubernetes Service (CKS) clusters, optionally filtered by zone and name, to import them.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "Only list the clusters in this zone.",
+				Optional:            true,
+			},
+			"name_regex": coreweave.ListNameRegexAttribute("clusters"),
+		},
+	}
+}
+
+func (l *ClusterListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*coreweave.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *coreweave.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	l.client = client
+}
+
+func (l *ClusterListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data ClusterListResourceModel
+	diagnostics := req.Config.Get(ctx, &data)
+	nameRegex := coreweave.CompileNameRegex(data.NameRegex, &diagnostics)
+	if diagnostics.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+
+	// ListClusters is not paginated: a single response holds every cluster of the organization.
+	listResp, err := l.client.ListClusters(ctx, connect.NewRequest(&cksv1beta1.ListClustersRequest{}))
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, &diagnostics)
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+
+	clusters := slices.DeleteFunc(slices.Clone(listResp.Msg.Items), func(cluster *cksv1beta1.Cluster) bool {
+		return !coreweave.MatchesFilters(nameRegex, data.Zone, types.StringNull(), cluster.Name, cluster.Zone, "")
+	})
+	slices.SortFunc(clusters, func(a, b *cksv1beta1.Cluster) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Zone, b.Zone), cmp.Compare(a.Id, b.Id))
+	})
+
+	stream.Results = coreweave.ListResults(ctx, req, clusters, func(cluster *cksv1beta1.Cluster, result *list.ListResult) {
+		result.DisplayName = cluster.Name
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), cluster.Id)...)
+		if !req.IncludeResource {
+			return
+		}
+
+		// as on import, the lists that Set leaves untouched start out null
+		state := clusterResourceStateModel{
+			ClusterResourceModel: ClusterResourceModel{
+				InternalLBCidrNames:   types.ListNull(types.StringType),
+				InternalLBCidrNamesV6: types.ListNull(types.StringType),
+			},
+			Timeouts: coreweave.NullTimeouts(ctx),
+		}
+		state.Set(cluster)
+		result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+	})
+}
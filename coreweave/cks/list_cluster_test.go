@@ -0,0 +1,88 @@
+package cks_test
+
+import (
+	"testing"
+
+	cksv1beta1 "buf.build/gen/go/coreweave/cks/protocolbuffers/go/coreweave/cks/v1beta1"
+	networkingv1beta1 "buf.build/gen/go/coreweave/networking/protocolbuffers/go/coreweave/networking/v1beta1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave/cks"
+	"github.com/coreweave/terraform-provider-coreweave/internal/testutil"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterListResource_List(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.NewFakeClient(t)
+	ids := map[string]string{}
+	for _, zone := range []string{"US-EAST-04A", "US-WEST-01A"} {
+		vpc, err := client.CreateVPC(t.Context(), connect.NewRequest(&networkingv1beta1.CreateVPCRequest{Name: "default", Zone: zone}))
+		require.NoError(t, err)
+		for _, name := range []string{"prod", "dev"} {
+			created, err := client.CreateCluster(t.Context(), connect.NewRequest(&cksv1beta1.CreateClusterRequest{
+				Name:  name + "-" + zone,
+				Zone:  zone,
+				VpcId: vpc.Msg.GetVpc().GetId(),
+			}))
+			require.NoError(t, err)
+			ids[name+"-"+zone] = created.Msg.GetCluster().GetId()
+		}
+	}
+
+	tests := []struct {
+		name   string
+		config map[string]any
+		limit  int64
+		want   []string
+	}{
+		{
+			name: "all clusters, sorted by name",
+			want: []string{"dev-US-EAST-04A", "dev-US-WEST-01A", "prod-US-EAST-04A", "prod-US-WEST-01A"},
+		},
+		{
+			name:   "by zone and name regex",
+			config: map[string]any{"zone": "US-WEST-01A", "name_regex": "^prod-"},
+			want:   []string{"prod-US-WEST-01A"},
+		},
+		{
+			name:  "up to the limit",
+			limit: 1,
+			want:  []string{"dev-US-EAST-04A"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			results := testutil.ListResource(t, cks.NewClusterListResource(), cks.NewClusterResource(), client, tt.config, true, tt.limit)
+
+			names := make([]string, len(results))
+			for i, result := range results {
+				require.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+				names[i] = result.DisplayName
+
+				var id, stateID, zone types.String
+				require.False(t, result.Identity.GetAttribute(t.Context(), path.Root("id"), &id).HasError())
+				require.False(t, result.Resource.GetAttribute(t.Context(), path.Root("id"), &stateID).HasError())
+				require.False(t, result.Resource.GetAttribute(t.Context(), path.Root("zone"), &zone).HasError())
+				assert.Equal(t, ids[result.DisplayName], id.ValueString())
+				assert.Equal(t, id, stateID)
+				assert.Contains(t, result.DisplayName, zone.ValueString())
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+
+	t.Run("invalid name regex", func(t *testing.T) {
+		t.Parallel()
+
+		results := testutil.ListResource(t, cks.NewClusterListResource(), cks.NewClusterResource(), client, map[string]any{"name_regex": "("}, false, 0)
+		require.Len(t, results, 1)
+		assert.True(t, results[0].Diagnostics.HasError())
+	})
+}
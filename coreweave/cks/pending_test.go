@@ -0,0 +1,248 @@
+package cks_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cksv1beta1 "buf.build/gen/go/coreweave/cks/protocolbuffers/go/coreweave/cks/v1beta1"
+	networkingv1beta1 "buf.build/gen/go/coreweave/networking/protocolbuffers/go/coreweave/networking/v1beta1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave/cks"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave/fake"
+	"github.com/coreweave/terraform-provider-coreweave/internal/testutil"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pendingClusterDelay is how long the clusters of the pending operation tests stay CREATING or UPDATING.
+const pendingClusterDelay = 500 * time.Millisecond
+
+// newCreatingCluster creates a cluster named "prod" that is still CREATING in a fake API with the given options, and
+// returns a cluster resource configured with its client and the state of the cluster with the given timeouts.
+func newCreatingCluster(t *testing.T, timeouts map[string]string, opts ...fake.Option) (*cks.ClusterResource, *coreweave.Client, cks.ClusterResourceStateModel) {
+	t.Helper()
+
+	client := testutil.NewFakeClientWithOptions(t, append([]fake.Option{fake.WithDelay(pendingClusterDelay)}, opts...)...)
+	vpc, err := client.CreateVPC(t.Context(), connect.NewRequest(&networkingv1beta1.CreateVPCRequest{Name: "default", Zone: "US-EAST-04A"}))
+	require.NoError(t, err)
+	created, err := client.CreateCluster(t.Context(), connect.NewRequest(&cksv1beta1.CreateClusterRequest{
+		Name:  "prod",
+		Zone:  "US-EAST-04A",
+		VpcId: vpc.Msg.GetVpc().GetId(),
+	}))
+	require.NoError(t, err)
+
+	state := cks.ClusterResourceStateModel{
+		ClusterResourceModel: cks.ClusterResourceModel{
+			InternalLBCidrNames:   types.ListNull(types.StringType),
+			InternalLBCidrNamesV6: types.ListNull(types.StringType),
+		},
+		AdoptExisting: types.BoolValue(false),
+		Timeouts:      testutil.Timeouts(t, timeouts),
+	}
+	state.Set(created.Msg.GetCluster())
+	return testutil.ConfigureResource(t, &cks.ClusterResource{}, client), client, state
+}
+
+// readCluster reads the cluster resource r from state, with a pending create.
+func readCluster(t *testing.T, r *cks.ClusterResource, model cks.ClusterResourceStateModel) (cks.ClusterResourceStateModel, *coreweave.PendingOperation, diag.Diagnostics) {
+	t.Helper()
+	ctx := t.Context()
+
+	state, identity := testutil.ResourceState(t, r, &model)
+	req := resource.ReadRequest{State: state}
+	testutil.NewPrivateState(&req.Private)
+	require.Empty(t, coreweave.SetPendingOperation(ctx, req.Private, "create"))
+	resp := resource.ReadResponse{State: state, Identity: identity, Private: req.Private}
+	r.Read(ctx, req, &resp)
+
+	var got cks.ClusterResourceStateModel
+	require.False(t, resp.State.Get(ctx, &got).HasError())
+	op, diagnostics := coreweave.GetPendingOperation(ctx, resp.Private)
+	require.Empty(t, diagnostics)
+	return got, op, resp.Diagnostics
+}
+
+func TestClusterResource_ReadPendingCreate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("resumes waiting for the create", func(t *testing.T) {
+		t.Parallel()
+
+		r, _, model := newCreatingCluster(t, nil)
+		got, op, diagnostics := readCluster(t, r, model)
+
+		assert.Empty(t, diagnostics)
+		assert.Equal(t, cksv1beta1.Cluster_STATUS_RUNNING.String(), got.Status.ValueString())
+		assert.Nil(t, op, "the create is no longer pending")
+	})
+
+	t.Run("warns once the create timeout has elapsed", func(t *testing.T) {
+		t.Parallel()
+
+		r, _, model := newCreatingCluster(t, map[string]string{"create": "1ns"})
+		got, op, diagnostics := readCluster(t, r, model)
+
+		require.False(t, diagnostics.HasError(), diagnostics)
+		require.Len(t, diagnostics.Warnings(), 1)
+		assert.Equal(t, "Operation Still In Progress", diagnostics.Warnings()[0].Summary())
+		assert.Equal(t, cksv1beta1.Cluster_STATUS_CREATING.String(), got.Status.ValueString())
+		require.NotNil(t, op, "the create is still pending")
+		assert.Equal(t, "create", op.Operation)
+	})
+
+	t.Run("waits no longer than the read timeout", func(t *testing.T) {
+		t.Parallel()
+
+		r, _, model := newCreatingCluster(t, map[string]string{"read": "100ms"})
+		started := time.Now()
+		got, op, diagnostics := readCluster(t, r, model)
+
+		assert.Less(t, time.Since(started), pendingClusterDelay)
+		require.False(t, diagnostics.HasError(), diagnostics)
+		require.Len(t, diagnostics.Warnings(), 1)
+		assert.Equal(t, "Operation Still In Progress", diagnostics.Warnings()[0].Summary())
+		assert.Equal(t, cksv1beta1.Cluster_STATUS_CREATING.String(), got.Status.ValueString())
+		assert.NotNil(t, op, "the create is still pending")
+	})
+
+	t.Run("warns when the resumed create fails", func(t *testing.T) {
+		t.Parallel()
+
+		r, _, model := newCreatingCluster(t, nil, fake.WithFailingNames("prod"))
+		got, op, diagnostics := readCluster(t, r, model)
+
+		require.False(t, diagnostics.HasError(), diagnostics)
+		require.Len(t, diagnostics.Warnings(), 1)
+		assert.Equal(t, "Operation Failed", diagnostics.Warnings()[0].Summary())
+		assert.Equal(t, cksv1beta1.Cluster_STATUS_FAILED.String(), got.Status.ValueString())
+		assert.Nil(t, op, "the failed create is no longer pending")
+	})
+}
+
+func TestClusterResource_CreateInterrupted(t *testing.T) {
+	t.Parallel()
+
+	r, _, model := newCreatingCluster(t, nil)
+	model.Name = types.StringValue("dev")
+	plan, identity := testutil.ResourceState(t, r, &model)
+
+	// interrupt the create while it is waiting for the cluster to be RUNNING, as Terraform does when it is stopped
+	ctx, cancel := context.WithCancel(t.Context())
+	time.AfterFunc(pendingClusterDelay/2, cancel)
+	req := resource.CreateRequest{Plan: tfsdk.Plan(plan)}
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Schema.Type().TerraformType(ctx), nil)}, Identity: identity}
+	testutil.NewPrivateState(&resp.Private)
+	r.Create(ctx, req, &resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "an interrupted create does not fail, which would taint the cluster: %v", resp.Diagnostics)
+	require.Len(t, resp.Diagnostics.Warnings(), 1)
+	assert.Equal(t, "Operation Interrupted", resp.Diagnostics.Warnings()[0].Summary())
+
+	var got cks.ClusterResourceStateModel
+	require.False(t, resp.State.Get(t.Context(), &got).HasError())
+	assert.Equal(t, cksv1beta1.Cluster_STATUS_CREATING.String(), got.Status.ValueString())
+	op, diagnostics := coreweave.GetPendingOperation(t.Context(), resp.Private)
+	require.Empty(t, diagnostics)
+	require.NotNil(t, op, "the create is pending")
+	assert.Equal(t, "create", op.Operation)
+}
+
+func TestClusterResource_UpdatePendingCreate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		timeouts map[string]string
+		wantErr  bool
+	}{
+		{
+			name: "waits for the create before updating",
+		},
+		{
+			name:     "fails without updating once the update timeout has elapsed",
+			timeouts: map[string]string{"update": "1ns"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			r, client, model := newCreatingCluster(t, tt.timeouts)
+			state, identity := testutil.ResourceState(t, r, &model)
+			model.Public = types.BoolValue(true)
+			plan, _ := testutil.ResourceState(t, r, &model)
+
+			req := resource.UpdateRequest{Plan: tfsdk.Plan(plan), State: state}
+			testutil.NewPrivateState(&req.Private)
+			require.Empty(t, coreweave.SetPendingOperation(ctx, req.Private, "create"))
+			resp := resource.UpdateResponse{State: state, Identity: identity, Private: req.Private}
+			r.Update(ctx, req, &resp)
+
+			cluster, err := client.GetCluster(ctx, connect.NewRequest(&cksv1beta1.GetClusterRequest{Id: model.Id.ValueString()}))
+			require.NoError(t, err)
+			op, diagnostics := coreweave.GetPendingOperation(ctx, resp.Private)
+			require.Empty(t, diagnostics)
+
+			if tt.wantErr {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.False(t, cluster.Msg.GetCluster().GetPublic(), "the cluster is not updated")
+				assert.NotNil(t, op, "the create is still pending")
+				return
+			}
+
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.True(t, cluster.Msg.GetCluster().GetPublic())
+			assert.Equal(t, cksv1beta1.Cluster_STATUS_RUNNING, cluster.Msg.GetCluster().GetStatus())
+			assert.Nil(t, op, "no operation is pending")
+		})
+	}
+}
+
+func TestClusterResource_ModifyPlanPendingOperation(t *testing.T) {
+	t.Parallel()
+
+	r, _, model := newCreatingCluster(t, nil)
+	state, _ := testutil.ResourceState(t, r, &model)
+	model.Public = types.BoolValue(true)
+	changed, _ := testutil.ResourceState(t, r, &model)
+
+	tests := []struct {
+		name    string
+		plan    tfsdk.State
+		pending bool
+		want    int
+	}{
+		{name: "change with a pending operation", plan: changed, pending: true, want: 1},
+		{name: "no change with a pending operation", plan: state, pending: true},
+		{name: "change without a pending operation", plan: changed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			req := resource.ModifyPlanRequest{State: state, Plan: tfsdk.Plan(tt.plan)}
+			testutil.NewPrivateState(&req.Private)
+			if tt.pending {
+				require.Empty(t, coreweave.SetPendingOperation(ctx, req.Private, "update"))
+			}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan, Private: req.Private}
+			r.ModifyPlan(ctx, req, &resp)
+
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Len(t, resp.Diagnostics.Warnings(), tt.want)
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"slices"
+	"strings"
 	"time"
 
 	cksv1beta1 "buf.build/gen/go/coreweave/cks/protocolbuffers/go/coreweave/cks/v1beta1"
@@ -14,12 +16,15 @@ import (
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -31,22 +36,50 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/zclconf/go-cty/cty"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
 const (
 	ServiceAccountOIDCBaseURL = "https://oidc.cks.coreweave.com"
+
+	defaultClusterCreateTimeout = 45 * time.Minute
+	defaultClusterReadTimeout   = 5 * time.Minute
+	defaultClusterUpdateTimeout = 20 * time.Minute
+	defaultClusterDeleteTimeout = 20 * time.Minute
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_                        resource.Resource                = &ClusterResource{}
 	_                        resource.ResourceWithImportState = &ClusterResource{}
+	_                        resource.ResourceWithIdentity    = &ClusterResource{}
+	_                        resource.ResourceWithModifyPlan  = &ClusterResource{}
 	errClusterCreationFailed error                            = errors.New("cluster creation failed")
 	nonWhitespace                                             = regexp.MustCompile(`\S`)
 )
 
+// clusterPendingStatuses are the statuses of a cluster while an operation on it is in progress, by operation.
+var clusterPendingStatuses = map[string][]string{
+	"create": {
+		cksv1beta1.Cluster_STATUS_CREATING.String(),
+		cksv1beta1.Cluster_STATUS_UNSPECIFIED.String(),
+	},
+	"update": {
+		cksv1beta1.Cluster_STATUS_UPDATING.String(),
+		cksv1beta1.Cluster_STATUS_UPGRADING.String(),
+		cksv1beta1.Cluster_STATUS_UNSPECIFIED.String(),
+	},
+}
+
+// clusterFailedStatuses are the statuses of a cluster whose operation did not finish successfully.
+var clusterFailedStatuses = []string{
+	cksv1beta1.Cluster_STATUS_ERROR.String(),
+	cksv1beta1.Cluster_STATUS_FAILED.String(),
+	cksv1beta1.Cluster_STATUS_UPGRADE_FAILED.String(),
+}
+
 func NewClusterResource() resource.Resource {
 	return &ClusterResource{}
 }
@@ -169,6 +202,14 @@ type ClusterResourceModel struct {
 	Kubelet                     jsontypes.Normalized      `tfsdk:"kubelet"`
 }
 
+// clusterResourceStateModel extends ClusterResourceModel with the attributes that only exist on the resource,
+// so that ClusterDataSourceModel can continue to share the cluster fields and their Set logic.
+type clusterResourceStateModel struct {
+	ClusterResourceModel
+	AdoptExisting types.Bool     `tfsdk:"adopt_existing"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
 func nodePortEmpty(np *cksv1beta1.PortRange) bool {
 	if np == nil {
 		return true
@@ -438,6 +479,22 @@ func (c *ClusterResourceModel) NodePorts() *cksv1beta1.PortRange {
 	}
 }
 
+// clusterFieldPath maps API field paths onto the cluster schema, which flattens the network configuration into
+// top-level attributes and takes the kubelet configuration as a single JSON attribute.
+func clusterFieldPath(field string) (path.Path, bool) {
+	field = coreweave.TrimSpecField(field)
+	switch {
+	case field == "kubelet" || strings.HasPrefix(field, "kubelet."):
+		return path.Root("kubelet"), true
+	case strings.HasPrefix(field, "network.service_node_port_range"):
+		field = "node_port_range" + strings.TrimPrefix(field, "network.service_node_port_range")
+	case strings.HasPrefix(field, "network."):
+		field = strings.TrimPrefix(field, "network.")
+	}
+
+	return coreweave.FieldPath(field)
+}
+
 func (c *ClusterResourceModel) ToCreateRequest(ctx context.Context) *cksv1beta1.CreateClusterRequest {
 	req := &cksv1beta1.CreateClusterRequest{
 		Name:    c.Name.ValueString(),
@@ -1082,6 +1139,21 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 					kubeletValidator{},
 				},
 			},
+			"adopt_existing": coreweave.AdoptExistingAttribute("name and zone"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *ClusterResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique identifier of the cluster.",
+			},
 		},
 	}
 }
@@ -1105,60 +1177,89 @@ func (r *ClusterResource) Configure(_ context.Context, req resource.ConfigureReq
 	r.client = client
 }
 
+// ModifyPlan warns when a change is planned to a cluster with a pending operation, as the apply waits for it first.
+func (r *ClusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	coreweave.AddPendingOperationPlanWarning(ctx, req, resp, "cluster")
+}
+
 func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data ClusterResourceModel
+	var data clusterResourceStateModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	createResp, err := r.client.CreateCluster(ctx, connect.NewRequest(data.ToCreateRequest(ctx)))
-	if err != nil {
-		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultClusterCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	createReq := data.ToCreateRequest(ctx)
+	var created *cksv1beta1.Cluster
+	createResp, err := r.client.CreateCluster(ctx, connect.NewRequest(createReq))
+	switch {
+	case err == nil:
+		created = createResp.Msg.Cluster
+	case data.AdoptExisting.ValueBool() && coreweave.IsAlreadyExistsError(err):
+		if created = r.findAdoptable(ctx, createReq, &resp.Diagnostics); created == nil {
+			return
+		}
+	default:
+		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics, coreweave.WithFieldPaths(clusterFieldPath))
 		return
 	}
 
 	// set state once cluster is created
-	data.Set(createResp.Msg.Cluster)
+	data.Set(created)
 	// if we fail to set state, return early as the resource will be orphaned
 	if diag := resp.State.Set(ctx, &data); diag.HasError() {
 		resp.Diagnostics.Append(diag...)
 		return
 	}
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("id"), data.Id)...)
+	// record the create as pending, so that later runs resume waiting for it if this one is interrupted
+	resp.Diagnostics.Append(coreweave.SetPendingOperation(ctx, resp.Private, "create")...)
 
 	// wait for the cluster to become ready
 	conf := retry.StateChangeConf{
-		Pending: []string{
-			cksv1beta1.Cluster_STATUS_CREATING.String(),
-			cksv1beta1.Cluster_STATUS_UNSPECIFIED.String(),
-		},
-		Target: []string{cksv1beta1.Cluster_STATUS_RUNNING.String()},
-		Refresh: func() (result interface{}, state string, err error) {
-			resp, err := r.client.GetCluster(ctx, connect.NewRequest(&cksv1beta1.GetClusterRequest{
-				Id: createResp.Msg.Cluster.Id,
-			}))
-			if err != nil {
-				tflog.Error(ctx, "failed to fetch cluster resource", map[string]interface{}{
-					"error": err,
-				})
-				return nil, cksv1beta1.Cluster_STATUS_UNSPECIFIED.String(), err
-			}
+		Pending: clusterPendingStatuses["create"],
+		Target:  []string{cksv1beta1.Cluster_STATUS_RUNNING.String()},
+		Timeout: createTimeout,
+	}
 
-			if resp.Msg.Cluster.Status == cksv1beta1.Cluster_STATUS_FAILED {
-				return resp.Msg.Cluster, resp.Msg.Cluster.Status.String(), errClusterCreationFailed
-			}
+	rawCluster, err := coreweave.WaitForState(ctx, "coreweave_cks_cluster", "create", conf, func(ctx context.Context) (result interface{}, state string, err error) {
+		resp, err := r.client.GetCluster(ctx, connect.NewRequest(&cksv1beta1.GetClusterRequest{
+			Id: created.Id,
+		}))
+		if err != nil {
+			tflog.Error(ctx, "failed to fetch cluster resource", map[string]interface{}{
+				"error": err,
+			})
+			return nil, cksv1beta1.Cluster_STATUS_UNSPECIFIED.String(), err
+		}
 
-			return resp.Msg.Cluster, resp.Msg.Cluster.Status.String(), nil
-		},
-		Timeout: 45 * time.Minute,
-	}
+		if resp.Msg.Cluster.Status == cksv1beta1.Cluster_STATUS_FAILED {
+			return resp.Msg.Cluster, resp.Msg.Cluster.Status.String(), errClusterCreationFailed
+		}
 
-	rawCluster, err := conf.WaitForStateContext(ctx)
+		return resp.Msg.Cluster, resp.Msg.Cluster.Status.String(), nil
+	})
 	if err != nil && !errors.Is(err, errClusterCreationFailed) {
+		if coreweave.IsInterrupted(err) {
+			// failing would taint the cluster, so keep the create pending for the next run to resume waiting for it
+			coreweave.AddInterruptedWarning(&resp.Diagnostics, "cluster", created.Id, "create")
+			return
+		}
+		resp.Diagnostics.Append(coreweave.ClearPendingOperation(ctx, resp.Private)...)
 		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
 		return
 	}
+	resp.Diagnostics.Append(coreweave.ClearPendingOperation(ctx, resp.Private)...)
 
 	cluster, ok := rawCluster.(*cksv1beta1.Cluster)
 	if !ok {
@@ -1179,14 +1280,53 @@ func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// findAdoptable returns the cluster with the name and zone of createReq, to adopt into state after creating it failed
+// because it already exists. It adds an error and returns nil if there is no such cluster, or it differs from
+// createReq.
+func (r *ClusterResource) findAdoptable(ctx context.Context, createReq *cksv1beta1.CreateClusterRequest, diagnostics *diag.Diagnostics) *cksv1beta1.Cluster {
+	listResp, err := r.client.ListClusters(ctx, connect.NewRequest(&cksv1beta1.ListClustersRequest{}))
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, diagnostics)
+		return nil
+	}
+
+	cluster, ok := coreweave.FindAdoptable(diagnostics, "Cluster", createReq.Name, createReq, listResp.Msg.Items,
+		func(cluster *cksv1beta1.Cluster) bool {
+			return cluster.Name == createReq.Name && cluster.Zone == createReq.Zone
+		},
+		func(cluster *cksv1beta1.Cluster) proto.Message { return cluster },
+	)
+	if !ok {
+		return nil
+	}
+
+	tflog.Info(ctx, "adopting existing cluster", map[string]interface{}{"id": cluster.Id})
+	return cluster
+}
+
 func (r *ClusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data ClusterResourceModel
+	var data clusterResourceStateModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultClusterReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	op, diags := coreweave.GetPendingOperation(ctx, resp.Private)
+	resp.Diagnostics.Append(diags...)
+	if op != nil && op.Operation == "create" {
+		r.resumePendingCreate(ctx, &data, op, readTimeout, &resp.Diagnostics)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	cluster, err := r.client.GetCluster(ctx, connect.NewRequest(&cksv1beta1.GetClusterRequest{
 		Id: data.Id.ValueString(),
 	}))
@@ -1200,12 +1340,26 @@ func (r *ClusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	if op != nil {
+		status := cluster.Msg.Cluster.Status.String()
+		switch {
+		case slices.Contains(clusterPendingStatuses[op.Operation], status):
+			coreweave.AddPendingOperationWarning(&resp.Diagnostics, "cluster", data.Id.ValueString(), op, status)
+		case slices.Contains(clusterFailedStatuses, status):
+			coreweave.AddPendingOperationFailedWarning(&resp.Diagnostics, "cluster", data.Id.ValueString(), op, status)
+			resp.Diagnostics.Append(coreweave.ClearPendingOperation(ctx, resp.Private)...)
+		default:
+			resp.Diagnostics.Append(coreweave.ClearPendingOperation(ctx, resp.Private)...)
+		}
+	}
+
 	data.Set(cluster.Msg.Cluster)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("id"), data.Id)...)
 }
 
 func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data ClusterResourceModel
+	var data clusterResourceStateModel
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
@@ -1213,50 +1367,62 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	var state ClusterResourceModel
+	var state clusterResourceStateModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updateReq := buildUpdateRequest(ctx, &data, &state)
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultClusterUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	r.waitForPendingOperation(ctx, state.Id.ValueString(), updateTimeout, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq := buildUpdateRequest(ctx, &data.ClusterResourceModel, &state.ClusterResourceModel)
 
 	updateResp, err := r.client.UpdateCluster(ctx, connect.NewRequest(updateReq))
 	if err != nil {
-		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics, coreweave.WithFieldPaths(clusterFieldPath))
 		return
 	}
+	// record the update as pending, so that later runs resume waiting for it if this one is interrupted
+	resp.Diagnostics.Append(coreweave.SetPendingOperation(ctx, resp.Private, "update")...)
 
 	// wait for the cluster to become ready
 	conf := retry.StateChangeConf{
-		Pending: []string{
-			cksv1beta1.Cluster_STATUS_UPDATING.String(),
-			cksv1beta1.Cluster_STATUS_UPGRADING.String(),
-			cksv1beta1.Cluster_STATUS_UNSPECIFIED.String(),
-		},
-		Target: []string{cksv1beta1.Cluster_STATUS_RUNNING.String()},
-		Refresh: func() (result interface{}, state string, err error) {
-			resp, err := r.client.GetCluster(ctx, connect.NewRequest(&cksv1beta1.GetClusterRequest{
-				Id: updateResp.Msg.Cluster.Id,
-			}))
-			if err != nil {
-				tflog.Error(ctx, "failed to fetch cluster resource", map[string]interface{}{
-					"error": err.Error(),
-				})
-				return nil, cksv1beta1.Cluster_STATUS_UNSPECIFIED.String(), err
-			}
-
-			return resp.Msg.Cluster, resp.Msg.Cluster.Status.String(), nil
-		},
-		Timeout: 20 * time.Minute,
+		Pending: clusterPendingStatuses["update"],
+		Target:  []string{cksv1beta1.Cluster_STATUS_RUNNING.String()},
+		Timeout: updateTimeout,
 	}
 
-	rawCluster, err := conf.WaitForStateContext(ctx)
+	rawCluster, err := coreweave.WaitForState(ctx, "coreweave_cks_cluster", "update", conf, func(ctx context.Context) (result interface{}, state string, err error) {
+		resp, err := r.client.GetCluster(ctx, connect.NewRequest(&cksv1beta1.GetClusterRequest{
+			Id: updateResp.Msg.Cluster.Id,
+		}))
+		if err != nil {
+			tflog.Error(ctx, "failed to fetch cluster resource", map[string]interface{}{
+				"error": err.Error(),
+			})
+			return nil, cksv1beta1.Cluster_STATUS_UNSPECIFIED.String(), err
+		}
+
+		return resp.Msg.Cluster, resp.Msg.Cluster.Status.String(), nil
+	})
 	if err != nil {
 		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
 		return
 	}
+	resp.Diagnostics.Append(coreweave.ClearPendingOperation(ctx, resp.Private)...)
 
 	cluster, ok := rawCluster.(*cksv1beta1.Cluster)
 	if !ok {
@@ -1269,16 +1435,100 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	data.Set(cluster)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("id"), data.Id)...)
+}
+
+// resumePendingCreate resumes waiting for the create of the cluster in data, if an earlier run was interrupted while
+// waiting for it, for what is left of its create timeout. It does not fail: the cluster is read afterwards, with a
+// warning if it is still being created.
+func (r *ClusterResource) resumePendingCreate(ctx context.Context, data *clusterResourceStateModel, op *coreweave.PendingOperation, readTimeout time.Duration, diagnostics *diag.Diagnostics) {
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultClusterCreateTimeout)
+	diagnostics.Append(diags...)
+	// a read waits no longer than the read timeout, so that refreshing does not block for the rest of the create
+	remaining := min(op.Remaining(createTimeout), readTimeout)
+	if diags.HasError() || remaining <= 0 {
+		return
+	}
+
+	tflog.Info(ctx, "resuming wait for pending cluster create", map[string]interface{}{
+		"id":         data.Id.ValueString(),
+		"started_at": op.StartedAt,
+		"remaining":  remaining.String(),
+	})
+	err := r.waitForOperation(ctx, data.Id.ValueString(), "create", remaining)
+	var unexpectedStateErr *retry.UnexpectedStateError
+	switch {
+	case err == nil, coreweave.IsInterrupted(err), coreweave.IsTimeout(err), errors.As(err, &unexpectedStateErr):
+		// the read that follows reports whether the create is still pending or has failed
+	default:
+		coreweave.AddResumeFailedWarning(diagnostics, "cluster", data.Id.ValueString(), op, err)
+	}
+}
+
+// waitForPendingOperation resumes waiting for the pending operation of the cluster with the given id, if an earlier
+// run was interrupted while waiting for it, so that the cluster is not changed while the operation is in progress.
+func (r *ClusterResource) waitForPendingOperation(ctx context.Context, id string, timeout time.Duration, private coreweave.PrivateState, diagnostics *diag.Diagnostics) {
+	op, diags := coreweave.GetPendingOperation(ctx, private)
+	diagnostics.Append(diags...)
+	if op == nil {
+		return
+	}
+
+	tflog.Info(ctx, "resuming wait for pending cluster operation", map[string]interface{}{
+		"id":         id,
+		"operation":  op.Operation,
+		"started_at": op.StartedAt,
+	})
+
+	if err := r.waitForOperation(ctx, id, op.Operation, timeout); err != nil {
+		coreweave.HandleAPIError(ctx, err, diagnostics)
+		return
+	}
+
+	diagnostics.Append(coreweave.ClearPendingOperation(ctx, private)...)
+}
+
+// waitForOperation waits up to timeout for the operation on the cluster with the given id to finish.
+func (r *ClusterResource) waitForOperation(ctx context.Context, id, operation string, timeout time.Duration) error {
+	conf := retry.StateChangeConf{
+		Pending: clusterPendingStatuses[operation],
+		Target:  []string{cksv1beta1.Cluster_STATUS_RUNNING.String()},
+		Timeout: timeout,
+	}
+
+	_, err := coreweave.WaitForState(ctx, "coreweave_cks_cluster", operation, conf, func(ctx context.Context) (result interface{}, state string, err error) {
+		resp, err := r.client.GetCluster(ctx, connect.NewRequest(&cksv1beta1.GetClusterRequest{
+			Id: id,
+		}))
+		if err != nil {
+			tflog.Error(ctx, "failed to fetch cluster resource", map[string]interface{}{
+				"error": err.Error(),
+			})
+			return nil, cksv1beta1.Cluster_STATUS_UNSPECIFIED.String(), err
+		}
+
+		return resp.Msg.Cluster, resp.Msg.Cluster.Status.String(), nil
+	})
+	return err
 }
 
 func (r *ClusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data ClusterResourceModel
+	var data clusterResourceStateModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultClusterDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	deleteResp, err := r.client.DeleteCluster(ctx, connect.NewRequest(&cksv1beta1.DeleteClusterRequest{
 		Id: data.Id.ValueString(),
 	}))
@@ -1295,29 +1545,28 @@ func (r *ClusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 			cksv1beta1.Cluster_STATUS_DELETING.String(),
 			cksv1beta1.Cluster_STATUS_UNSPECIFIED.String(),
 		},
-		Target: []string{cksv1beta1.Cluster_STATUS_DELETED.String()},
-		Refresh: func() (result interface{}, state string, err error) {
-			resp, err := r.client.GetCluster(ctx, connect.NewRequest(&cksv1beta1.GetClusterRequest{
-				Id: deleteResp.Msg.Cluster.Id,
-			}))
-			if err != nil {
-				var connectErr *connect.Error
-				if errors.As(err, &connectErr) && connectErr.Code() == connect.CodeNotFound {
-					return struct{}{}, cksv1beta1.Cluster_STATUS_DELETED.String(), nil
-				}
-
-				tflog.Error(ctx, "failed to fetch cluster resource", map[string]interface{}{
-					"error": err.Error(),
-				})
-				return nil, cksv1beta1.Cluster_STATUS_UNSPECIFIED.String(), err
+		Target:  []string{cksv1beta1.Cluster_STATUS_DELETED.String()},
+		Timeout: deleteTimeout,
+	}
+
+	_, err = coreweave.WaitForState(ctx, "coreweave_cks_cluster", "delete", conf, func(ctx context.Context) (result interface{}, state string, err error) {
+		resp, err := r.client.GetCluster(ctx, connect.NewRequest(&cksv1beta1.GetClusterRequest{
+			Id: deleteResp.Msg.Cluster.Id,
+		}))
+		if err != nil {
+			var connectErr *connect.Error
+			if errors.As(err, &connectErr) && connectErr.Code() == connect.CodeNotFound {
+				return struct{}{}, cksv1beta1.Cluster_STATUS_DELETED.String(), nil
 			}
 
-			return resp.Msg.Cluster, resp.Msg.Cluster.Status.String(), nil
-		},
-		Timeout: 20 * time.Minute,
-	}
+			tflog.Error(ctx, "failed to fetch cluster resource", map[string]interface{}{
+				"error": err.Error(),
+			})
+			return nil, cksv1beta1.Cluster_STATUS_UNSPECIFIED.String(), err
+		}
 
-	_, err = conf.WaitForStateContext(ctx)
+		return resp.Msg.Cluster, resp.Msg.Cluster.Status.String(), nil
+	})
 	if err != nil {
 		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
 		return
@@ -1325,7 +1574,7 @@ func (r *ClusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *ClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // MustRenderClusterResource is a helper to render HCL for use in acceptance testing.
@@ -12,24 +12,27 @@ import (
 	"buf.build/gen/go/coreweave/networking/connectrpc/go/coreweave/networking/v1beta1/networkingv1beta1connect"
 	"connectrpc.com/connect"
 
-	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"google.golang.org/genproto/googleapis/rpc/errdetails"
 )
 
-func NewClient(endpoint string, s3Endpoint string, timeout time.Duration, interceptors ...connect.Interceptor) *Client {
-	rc := retryablehttp.NewClient()
+func NewClient(endpoint string, s3Endpoint string, timeout time.Duration, retry RetryConfig, transport TransportConfig, interceptors ...connect.Interceptor) (*Client, error) {
+	settings, err := transport.load()
+	if err != nil {
+		return nil, err
+	}
+
+	rc := newRetryableClient(retry)
 	rc.HTTPClient.Timeout = timeout
-	rc.RetryMax = 10
-	rc.RetryWaitMin = 200 * time.Millisecond
-	rc.RetryWaitMax = 5 * time.Second
-	// Jittered exponential back-off (min*2^n) with capping.
-	rc.Backoff = retryablehttp.DefaultBackoff
-	// Treat only idempotent verbs + 502/503/504 + transport errors as retryable.
-	rc.CheckRetry = RetryPolicy
+	httpTransport := cleanhttp.DefaultPooledTransport()
+	settings.apply(httpTransport)
+	rc.HTTPClient.Transport = httpTransport
 
 	c := rc.StandardClient()
+	settings.wrap(c)
+	// classify calls for the retry policy first, so that the other interceptors see the idempotency key
+	interceptors = settings.interceptors(append([]connect.Interceptor{IdempotencyInterceptor()}, interceptors...))
 
 	return &Client{
 		ClusterServiceClient: cksv1beta1connect.NewClusterServiceClient(c, endpoint, connect.WithInterceptors(interceptors...)),
@@ -41,7 +44,9 @@ func NewClient(endpoint string, s3Endpoint string, timeout time.Duration, interc
 			GatewayServiceClient:       inferencev1alpha1connect.NewGatewayServiceClient(c, endpoint, connect.WithInterceptors(interceptors...)),
 		},
 		s3Endpoint: s3Endpoint,
-	}
+		retry:      &retry,
+		transport:  settings,
+	}, nil
 }
 
 // InferenceClient groups all inference service clients.
@@ -58,7 +63,18 @@ type Client struct {
 
 	Inference *InferenceClient
 
+	// Tags is the provider-level tag configuration for resources that support tags.
+	Tags *TagConfig
+
+	// Token is the API token the client authenticates with, which also authenticates to CKS api-servers.
+	Token string
+
+	// S3 customizes the S3 clients used for Object Storage.
+	S3 S3Config
+
 	s3Endpoint string
+	retry      *RetryConfig
+	transport  *transportSettings
 }
 
 func IsNotFoundError(err error) bool {
@@ -66,8 +82,12 @@ func IsNotFoundError(err error) bool {
 	return errors.As(err, &connectErr) && connectErr.Code() == connect.CodeNotFound
 }
 
+// HandleAPIError adds diagnostics describing err. Connect errors are rendered according to their code and any
+// google.rpc error details they carry; BadRequest field violations are attached to the attribute paths of the
+// offending fields, as mapped by FieldPath or the function given with WithFieldPaths.
+//
 //nolint:gocyclo
-func HandleAPIError(ctx context.Context, err error, diagnostics *diag.Diagnostics) {
+func HandleAPIError(ctx context.Context, err error, diagnostics *diag.Diagnostics, opts ...APIErrorOption) {
 	// Check if the error is a ConnectRPC error
 	var connectErr *connect.Error
 	if !errors.As(err, &connectErr) {
@@ -81,130 +101,104 @@ func HandleAPIError(ctx context.Context, err error, diagnostics *diag.Diagnostic
 		return
 	}
 
-	details := connectErr.Details()
+	cfg := apiErrorConfig{fieldPath: FieldPath}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	details := parseErrorDetails(ctx, connectErr)
+	extra := details.context()
+
+	added := 0
+	addError := func(summary, detail string) {
+		diagnostics.AddError(summary, detail+extra)
+		added++
+	}
 
 	//nolint:exhaustive
 	switch connectErr.Code() {
 	case connect.CodeNotFound:
-		for _, d := range details {
-			msg, valueErr := d.Value()
-			if valueErr != nil {
-				diagnostics.AddError(connectErr.Error(), connectErr.Message())
-				break
-			}
-			if notFound, ok := msg.(*errdetails.ResourceInfo); ok {
-				diagnostics.AddError(
-					"Not Found",
-					fmt.Sprintf("%s '%s' not found: %s", notFound.ResourceType, notFound.ResourceName, notFound.Description),
-				)
-				break
-			}
-
-			diagnostics.AddError(connectErr.Error(), connectErr.Message())
+		for _, notFound := range details.resourceInfo {
+			addError(
+				"Not Found",
+				fmt.Sprintf("%s '%s' not found: %s", notFound.ResourceType, notFound.ResourceName, notFound.Description),
+			)
 		}
-	case connect.CodeAlreadyExists:
-		for _, d := range details {
-			msg, valueErr := d.Value()
-			if valueErr != nil {
-				diagnostics.AddError(connectErr.Error(), connectErr.Message())
-				break
-			}
-			if alreadyExists, ok := msg.(*errdetails.ResourceInfo); ok {
-				diagnostics.AddError(
-					"Already Exists",
-					fmt.Sprintf("%s '%s' already exists: %s", alreadyExists.ResourceType, alreadyExists.ResourceName, alreadyExists.Description),
-				)
-				break
-			}
 
-			diagnostics.AddError(connectErr.Error(), connectErr.Message())
+	case connect.CodeAlreadyExists:
+		for _, alreadyExists := range details.resourceInfo {
+			addError(
+				"Already Exists",
+				fmt.Sprintf("%s '%s' already exists: %s", alreadyExists.ResourceType, alreadyExists.ResourceName, alreadyExists.Description),
+			)
 		}
+
 	case connect.CodeFailedPrecondition:
-		for _, d := range details {
-			msg, valueErr := d.Value()
-			if valueErr != nil {
-				diagnostics.AddError(connectErr.Error(), connectErr.Message())
-				break
-			}
-			if precondition, ok := msg.(*errdetails.PreconditionFailure); ok {
-				for _, violation := range precondition.Violations {
-					diagnostics.AddError(
-						"Failed Precondition",
-						violation.Type+": "+violation.Description,
-					)
-				}
-				break
+		for _, precondition := range details.precondition {
+			for _, violation := range precondition.Violations {
+				addError(
+					"Failed Precondition",
+					violation.Type+": "+violation.Description,
+				)
 			}
-
-			diagnostics.AddError(connectErr.Error(), connectErr.Message())
 		}
 
 	case connect.CodeInvalidArgument:
-		for _, d := range details {
-			msg, valueErr := d.Value()
-			if valueErr != nil {
-				diagnostics.AddError(connectErr.Error(), connectErr.Message())
-				break
-			}
-			if badRequest, ok := msg.(*errdetails.BadRequest); ok {
-				for _, field := range badRequest.FieldViolations {
-					diagnostics.AddError(
-						"Bad Request",
-						field.Field+": "+field.Description,
-					)
+		for _, badRequest := range details.badRequest {
+			for _, field := range badRequest.FieldViolations {
+				if attrPath, ok := cfg.fieldPath(field.Field); ok {
+					diagnostics.AddAttributeError(attrPath, "Bad Request", field.Field+": "+field.Description+extra)
+					added++
+					continue
 				}
-				break
+				addError(
+					"Bad Request",
+					field.Field+": "+field.Description,
+				)
 			}
-
-			diagnostics.AddError(connectErr.Error(), connectErr.Message())
 		}
 
 	case connect.CodeUnauthenticated:
-		diagnostics.AddError(
+		addError(
 			"Unauthenticated",
 			connectErr.Error(),
 		)
 
 	case connect.CodePermissionDenied:
-		diagnostics.AddError(
+		addError(
 			"Unauthorized",
 			connectErr.Error(),
 		)
 
 	case connect.CodeResourceExhausted:
-		for _, d := range details {
-			msg, valueErr := d.Value()
-			if valueErr != nil {
-				diagnostics.AddError(connectErr.Error(), connectErr.Message())
-				break
-			}
-			if quotaFailure, ok := msg.(*errdetails.QuotaFailure); ok {
-				for _, violation := range quotaFailure.Violations {
-					diagnostics.AddError(
-						"Quota Exceeded",
-						violation.Subject+": "+violation.Description,
-					)
-				}
-				break
+		for _, quotaFailure := range details.quotaFailure {
+			for _, violation := range quotaFailure.Violations {
+				addError(
+					"Quota Exceeded",
+					violation.Subject+": "+violation.Description,
+				)
 			}
-
-			diagnostics.AddError(connectErr.Error(), connectErr.Message())
 		}
 
 	default:
+		if summary, ok := codeSummaries[connectErr.Code()]; ok {
+			addError(summary, connectErr.Message())
+			break
+		}
+
 		// Log and return a generic internal error for unexpected cases
 		tflog.Error(ctx, "unexpected error code", map[string]interface{}{
 			"code":    connectErr.Code(),
 			"message": connectErr.Message(),
 		})
-		diagnostics.AddError(
+		addError(
 			"Internal Error",
 			fmt.Sprintf("An unexpected server error occurred: %q. Please check the provider logs for more details.", err.Error()),
 		)
 	}
 
-	// safeguard for any buggy case statements
-	if !diagnostics.HasError() {
-		diagnostics.AddError(connectErr.Error(), connectErr.Message())
+	// fall back to the raw error if none of the details could be rendered
+	if added == 0 {
+		addError(connectErr.Error(), connectErr.Message())
 	}
 }
@@ -3,13 +3,31 @@ package coreweave_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/coreweave/terraform-provider-coreweave/coreweave"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
+func errorWithDetails(t *testing.T, code connect.Code, message string, details ...proto.Message) error {
+	t.Helper()
+
+	connectErr := connect.NewError(code, errors.New(message))
+	for _, d := range details {
+		detail, err := connect.NewErrorDetail(d)
+		require.NoError(t, err)
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
+
 func TestHandleAPIError(t *testing.T) {
 	t.Parallel()
 
@@ -32,6 +50,58 @@ func TestHandleAPIError(t *testing.T) {
 				"Internal Error",
 				"An unexpected server error occurred: \"internal: Internal server error\". Please check the provider logs for more details.",
 			)},
+		}, {
+			name: "Field violation with attribute path",
+			err: errorWithDetails(t, connect.CodeInvalidArgument, "invalid request", &errdetails.BadRequest{
+				FieldViolations: []*errdetails.BadRequest_FieldViolation{
+					{Field: "internal_lb_cidr_names[1]", Description: "unknown CIDR name"},
+					{Field: "not a field path", Description: "is invalid"},
+				},
+			}),
+			want: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("internal_lb_cidr_names").AtListIndex(1),
+					"Bad Request",
+					"internal_lb_cidr_names[1]: unknown CIDR name",
+				),
+				diag.NewErrorDiagnostic("Bad Request", "not a field path: is invalid"),
+			},
+		}, {
+			name: "Error context details",
+			err: errorWithDetails(t, connect.CodeResourceExhausted, "quota exceeded",
+				&errdetails.QuotaFailure{Violations: []*errdetails.QuotaFailure_Violation{
+					{Subject: "clusters", Description: "limit of 5 reached"},
+				}},
+				&errdetails.LocalizedMessage{Locale: "en-US", Message: "You have reached the cluster limit for your organization."},
+				&errdetails.ErrorInfo{Reason: "CLUSTER_LIMIT_REACHED", Domain: "cks.coreweave.com", Metadata: map[string]string{"zone": "US-EAST-04A", "limit": "5"}},
+				&errdetails.RetryInfo{RetryDelay: durationpb.New(30 * time.Second)},
+				&errdetails.Help{Links: []*errdetails.Help_Link{{Description: "Request a quota increase", Url: "https://docs.coreweave.com/quotas"}}},
+				&errdetails.RequestInfo{RequestId: "req-1234"},
+			),
+			want: diag.Diagnostics{diag.NewErrorDiagnostic(
+				"Quota Exceeded",
+				"clusters: limit of 5 reached\n\n"+
+					"You have reached the cluster limit for your organization.\n"+
+					"Reason: CLUSTER_LIMIT_REACHED (domain: cks.coreweave.com)\n"+
+					"Metadata: limit=5, zone=US-EAST-04A\n"+
+					"Retry after: 30s\n"+
+					"Help: Request a quota increase: https://docs.coreweave.com/quotas\n"+
+					"Request ID: req-1234",
+			)},
+		}, {
+			name: "Known code without details",
+			err:  errorWithDetails(t, connect.CodeUnavailable, "service is down for maintenance", &errdetails.RequestInfo{RequestId: "req-5678"}),
+			want: diag.Diagnostics{diag.NewErrorDiagnostic(
+				"Service Unavailable",
+				"service is down for maintenance\n\nRequest ID: req-5678",
+			)},
+		}, {
+			name: "Fallback without renderable details",
+			err:  errorWithDetails(t, connect.CodeNotFound, "cluster not found", &errdetails.ErrorInfo{Reason: "CLUSTER_NOT_FOUND"}),
+			want: diag.Diagnostics{diag.NewErrorDiagnostic(
+				"not_found: cluster not found",
+				"cluster not found\n\nReason: CLUSTER_NOT_FOUND",
+			)},
 		},
 	}
 
@@ -44,3 +114,80 @@ func TestHandleAPIError(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleAPIError_WithFieldPaths(t *testing.T) {
+	t.Parallel()
+
+	err := errorWithDetails(t, connect.CodeInvalidArgument, "invalid request", &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "spec.network.pod_cidr_name", Description: "must not be empty"},
+		},
+	})
+
+	var diagnostics diag.Diagnostics
+	coreweave.HandleAPIError(t.Context(), err, &diagnostics, coreweave.WithFieldPaths(func(field string) (path.Path, bool) {
+		assert.Equal(t, "spec.network.pod_cidr_name", field)
+		return path.Root("pod_cidr_name"), true
+	}))
+
+	assert.Equal(t, diag.Diagnostics{diag.NewAttributeErrorDiagnostic(
+		path.Root("pod_cidr_name"),
+		"Bad Request",
+		"spec.network.pod_cidr_name: must not be empty",
+	)}, diagnostics)
+}
+
+func TestFieldPath(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		field  string
+		want   path.Path
+		wantOk bool
+	}{
+		"top-level field": {field: "name", want: path.Root("name"), wantOk: true},
+		"nested field":    {field: "oidc.issuer_url", want: path.Root("oidc").AtName("issuer_url"), wantOk: true},
+		"list index":      {field: "traffic.weights[2].weight", want: path.Root("traffic").AtName("weights").AtListIndex(2).AtName("weight"), wantOk: true},
+		"map key":         {field: `labels["env"]`, want: path.Root("labels").AtMapKey("env"), wantOk: true},
+		"spec field":      {field: "spec.network.pod_cidr_name", want: path.Root("network").AtName("pod_cidr_name"), wantOk: true},
+		"empty":           {field: "", want: path.Empty(), wantOk: false},
+		"invalid segment": {field: "network.pod-cidr", want: path.Empty(), wantOk: false},
+		"invalid index":   {field: "zones[first]", want: path.Empty(), wantOk: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := coreweave.FieldPath(tt.field)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFieldPathsWithin(t *testing.T) {
+	t.Parallel()
+
+	fieldPath := coreweave.FieldPathsWithin(map[string]bool{"name": true, "runtime": true, "gateway_ids": false})
+	tests := map[string]struct {
+		field  string
+		want   path.Path
+		wantOk bool
+	}{
+		"mirrored field":        {field: "runtime.engine", want: path.Root("runtime").AtName("engine"), wantOk: true},
+		"spec field":            {field: "spec.name", want: path.Root("name"), wantOk: true},
+		"element of a set":      {field: "gateway_ids[1]", want: path.Root("gateway_ids"), wantOk: true},
+		"unknown root":          {field: "network.pod_cidr_name", want: path.Empty(), wantOk: false},
+		"unknown spec root":     {field: "spec.status", want: path.Empty(), wantOk: false},
+		"prefix of a known one": {field: "names", want: path.Empty(), wantOk: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := fieldPath(tt.field)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
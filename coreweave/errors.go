@@ -0,0 +1,220 @@
+package coreweave
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// FieldPathFunc maps the field of a google.rpc.BadRequest field violation, e.g. "network.pod_cidr_name", onto the
+// Terraform attribute path it is configured by. It returns false if the field has no matching attribute, in which case
+// the violation is reported without a path.
+type FieldPathFunc func(field string) (path.Path, bool)
+
+// APIErrorOption customizes how HandleAPIError renders an error.
+type APIErrorOption func(*apiErrorConfig)
+
+type apiErrorConfig struct {
+	fieldPath FieldPathFunc
+}
+
+// WithFieldPaths maps BadRequest field violations onto attribute paths with fn, for resources whose schema does not
+// mirror the API message. By default, FieldPath is used.
+func WithFieldPaths(fn FieldPathFunc) APIErrorOption {
+	return func(c *apiErrorConfig) {
+		c.fieldPath = fn
+	}
+}
+
+// fieldPathSegmentRe matches one segment of a proto field path: a field name followed by any number of list indexes or map keys.
+var fieldPathSegmentRe = regexp.MustCompile(`^([a-z_][a-z0-9_]*)((?:\[[^\]]+\])*)$`)
+
+// TrimSpecField removes the leading `spec.` from the field of a violation reported against the spec of an API object,
+// e.g. `spec.network.pod_cidr_name`, which is configured by the same attributes as the field of the request.
+func TrimSpecField(field string) string {
+	return strings.TrimPrefix(field, "spec.")
+}
+
+// FieldPath converts a proto field path such as `network.internal_lb_cidr_names[0]` or `labels["env"]` into the
+// attribute path of the same shape, after removing any leading `spec.`. This is correct whenever the resource schema
+// mirrors the API message.
+func FieldPath(field string) (path.Path, bool) {
+	field = TrimSpecField(field)
+	if field == "" {
+		return path.Empty(), false
+	}
+
+	var p path.Path
+	for i, segment := range strings.Split(field, ".") {
+		match := fieldPathSegmentRe.FindStringSubmatch(segment)
+		if match == nil {
+			return path.Empty(), false
+		}
+
+		if i == 0 {
+			p = path.Root(match[1])
+		} else {
+			p = p.AtName(match[1])
+		}
+
+		for _, key := range strings.Split(strings.TrimSuffix(strings.TrimPrefix(match[2], "["), "]"), "][") {
+			if key == "" {
+				continue
+			}
+			if unquoted, err := strconv.Unquote(key); err == nil {
+				p = p.AtMapKey(unquoted)
+			} else if index, err := strconv.Atoi(key); err == nil {
+				p = p.AtListIndex(index)
+			} else {
+				return path.Empty(), false
+			}
+		}
+	}
+
+	return p, true
+}
+
+// FieldPathsWithin returns a FieldPathFunc for resources whose schema only partly mirrors the API message. roots maps
+// each top-level field that is configured by the attribute of the same name to whether the fields nested in it mirror
+// that attribute too. Violations of nested fields that do not, e.g. the elements of a set, are reported on the
+// top-level attribute, and violations of any other field are reported without a path.
+func FieldPathsWithin(roots map[string]bool) FieldPathFunc {
+	return func(field string) (path.Path, bool) {
+		field = TrimSpecField(field)
+		root, _, _ := strings.Cut(field, ".")
+		root, _, _ = strings.Cut(root, "[")
+		nested, ok := roots[root]
+		switch {
+		case !ok:
+			return path.Empty(), false
+		case !nested:
+			return path.Root(root), true
+		}
+		return FieldPath(field)
+	}
+}
+
+// apiErrorDetails holds the google.rpc error details attached to a Connect error.
+type apiErrorDetails struct {
+	resourceInfo []*errdetails.ResourceInfo
+	precondition []*errdetails.PreconditionFailure
+	badRequest   []*errdetails.BadRequest
+	quotaFailure []*errdetails.QuotaFailure
+
+	errorInfo        []*errdetails.ErrorInfo
+	retryInfo        []*errdetails.RetryInfo
+	help             []*errdetails.Help
+	localizedMessage []*errdetails.LocalizedMessage
+	requestInfo      []*errdetails.RequestInfo
+}
+
+func parseErrorDetails(ctx context.Context, connectErr *connect.Error) apiErrorDetails {
+	var details apiErrorDetails
+
+	for _, d := range connectErr.Details() {
+		msg, err := d.Value()
+		if err != nil {
+			tflog.Warn(ctx, "failed to decode API error detail", map[string]any{
+				"type":  d.Type(),
+				"error": err.Error(),
+			})
+			continue
+		}
+
+		switch detail := msg.(type) {
+		case *errdetails.ResourceInfo:
+			details.resourceInfo = append(details.resourceInfo, detail)
+		case *errdetails.PreconditionFailure:
+			details.precondition = append(details.precondition, detail)
+		case *errdetails.BadRequest:
+			details.badRequest = append(details.badRequest, detail)
+		case *errdetails.QuotaFailure:
+			details.quotaFailure = append(details.quotaFailure, detail)
+		case *errdetails.ErrorInfo:
+			details.errorInfo = append(details.errorInfo, detail)
+		case *errdetails.RetryInfo:
+			details.retryInfo = append(details.retryInfo, detail)
+		case *errdetails.Help:
+			details.help = append(details.help, detail)
+		case *errdetails.LocalizedMessage:
+			details.localizedMessage = append(details.localizedMessage, detail)
+		case *errdetails.RequestInfo:
+			details.requestInfo = append(details.requestInfo, detail)
+		default:
+			tflog.Debug(ctx, "ignoring unsupported API error detail", map[string]any{
+				"type": d.Type(),
+			})
+		}
+	}
+
+	return details
+}
+
+// context renders the details that apply to the error as a whole, to be appended to each diagnostic it produces.
+// It returns an empty string if there are none.
+func (d apiErrorDetails) context() string {
+	var lines []string
+
+	for _, m := range d.localizedMessage {
+		lines = append(lines, m.GetMessage())
+	}
+	for _, info := range d.errorInfo {
+		line := "Reason: " + info.GetReason()
+		if info.GetDomain() != "" {
+			line += fmt.Sprintf(" (domain: %s)", info.GetDomain())
+		}
+		lines = append(lines, line)
+
+		if len(info.GetMetadata()) > 0 {
+			var metadata []string
+			for _, k := range slices.Sorted(maps.Keys(info.GetMetadata())) {
+				metadata = append(metadata, k+"="+info.GetMetadata()[k])
+			}
+			lines = append(lines, "Metadata: "+strings.Join(metadata, ", "))
+		}
+	}
+	for _, info := range d.retryInfo {
+		if info.GetRetryDelay() != nil {
+			lines = append(lines, "Retry after: "+info.GetRetryDelay().AsDuration().String())
+		}
+	}
+	for _, help := range d.help {
+		for _, link := range help.GetLinks() {
+			if link.GetDescription() != "" {
+				lines = append(lines, fmt.Sprintf("Help: %s: %s", link.GetDescription(), link.GetUrl()))
+			} else {
+				lines = append(lines, "Help: "+link.GetUrl())
+			}
+		}
+	}
+	for _, info := range d.requestInfo {
+		if info.GetRequestId() != "" {
+			lines = append(lines, "Request ID: "+info.GetRequestId())
+		}
+	}
+
+	if len(lines) == 0 {
+		return ""
+	}
+	return "\n\n" + strings.Join(lines, "\n")
+}
+
+// codeSummaries are the diagnostic summaries for error codes that HandleAPIError has no specific handling for.
+var codeSummaries = map[connect.Code]string{
+	connect.CodeCanceled:         "Request Canceled",
+	connect.CodeDeadlineExceeded: "Deadline Exceeded",
+	connect.CodeAborted:          "Aborted",
+	connect.CodeOutOfRange:       "Out of Range",
+	connect.CodeUnimplemented:    "Not Implemented",
+	connect.CodeUnavailable:      "Service Unavailable",
+	connect.CodeDataLoss:         "Data Loss",
+}
@@ -0,0 +1,127 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"buf.build/gen/go/coreweave/cks/connectrpc/go/coreweave/cks/v1beta1/cksv1beta1connect"
+	cksv1beta1 "buf.build/gen/go/coreweave/cks/protocolbuffers/go/coreweave/cks/v1beta1"
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const clusterResourceType = "cluster"
+
+type clusterEntry struct {
+	*cksv1beta1.Cluster
+}
+
+func (e *clusterEntry) id() string {
+	return e.GetId()
+}
+
+func (e *clusterEntry) settle(now time.Time) {
+	e.Status = cksv1beta1.Cluster_STATUS_RUNNING
+	e.UpdatedAt = timestamppb.New(now)
+	if e.ApiServerEndpoint == "" {
+		e.ApiServerEndpoint = fmt.Sprintf("%s.k8s.%s.fake.coreweave.com", e.GetId(), e.GetZone())
+	}
+}
+
+func (e *clusterEntry) name() string {
+	return e.GetName()
+}
+
+func (e *clusterEntry) fail(now time.Time) {
+	e.Status = cksv1beta1.Cluster_STATUS_FAILED
+	e.UpdatedAt = timestamppb.New(now)
+}
+
+type clusterService struct {
+	cksv1beta1connect.UnimplementedClusterServiceHandler
+
+	server *Server
+}
+
+func (s *clusterService) ListClusters(_ context.Context, _ *connect.Request[cksv1beta1.ListClustersRequest]) (*connect.Response[cksv1beta1.ListClustersResponse], error) {
+	resp := &cksv1beta1.ListClustersResponse{}
+	s.server.clusters.list(func(e *clusterEntry) {
+		resp.Items = append(resp.Items, clone(e.Cluster))
+	})
+	return connect.NewResponse(resp), nil
+}
+
+func (s *clusterService) GetCluster(_ context.Context, req *connect.Request[cksv1beta1.GetClusterRequest]) (*connect.Response[cksv1beta1.GetClusterResponse], error) {
+	resp := &cksv1beta1.GetClusterResponse{}
+	if !s.server.clusters.get(req.Msg.GetId(), func(e *clusterEntry) { resp.Cluster = clone(e.Cluster) }) {
+		return nil, notFound(clusterResourceType, req.Msg.GetId())
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *clusterService) CreateCluster(_ context.Context, req *connect.Request[cksv1beta1.CreateClusterRequest]) (*connect.Response[cksv1beta1.CreateClusterResponse], error) {
+	msg := req.Msg
+	switch {
+	case msg.GetName() == "":
+		return nil, invalidArgument("name", "must not be empty")
+	case msg.GetZone() == "":
+		return nil, invalidArgument("zone", "must not be empty")
+	case msg.GetVpcId() == "":
+		return nil, invalidArgument("vpc_id", "must not be empty")
+	case !s.server.vpcs.get(msg.GetVpcId(), func(*vpcEntry) {}):
+		return nil, invalidArgument("vpc_id", fmt.Sprintf("VPC %q does not exist", msg.GetVpcId()))
+	}
+
+	now := timestamppb.New(s.server.now())
+	cluster := &cksv1beta1.Cluster{
+		Id:        newID(),
+		Status:    cksv1beta1.Cluster_STATUS_CREATING,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	copyFields(cluster.ProtoReflect(), msg.ProtoReflect())
+
+	err := s.server.clusters.create(&clusterEntry{cluster}, func(existing *clusterEntry) bool {
+		return existing.GetName() == cluster.GetName() && existing.GetZone() == cluster.GetZone()
+	})
+	if err != nil {
+		return nil, apiError(clusterResourceType, cluster.GetName(), err)
+	}
+	return connect.NewResponse(&cksv1beta1.CreateClusterResponse{Cluster: clone(cluster)}), nil
+}
+
+func (s *clusterService) UpdateCluster(_ context.Context, req *connect.Request[cksv1beta1.UpdateClusterRequest]) (*connect.Response[cksv1beta1.UpdateClusterResponse], error) {
+	resp := &cksv1beta1.UpdateClusterResponse{}
+	found, err := s.server.clusters.update(req.Msg.GetId(), func(e *clusterEntry) error {
+		if paths := req.Msg.GetUpdateMask().GetPaths(); len(paths) > 0 {
+			applyMask(e.ProtoReflect(), req.Msg.ProtoReflect(), paths)
+		} else {
+			copyFields(e.ProtoReflect(), req.Msg.ProtoReflect(), "id", "update_mask")
+		}
+		e.Status = cksv1beta1.Cluster_STATUS_UPDATING
+		e.UpdatedAt = timestamppb.New(s.server.now())
+		resp.Cluster = clone(e.Cluster)
+		return nil
+	})
+	if !found {
+		return nil, notFound(clusterResourceType, req.Msg.GetId())
+	}
+	if err != nil {
+		return nil, apiError(clusterResourceType, req.Msg.GetId(), err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *clusterService) DeleteCluster(_ context.Context, req *connect.Request[cksv1beta1.DeleteClusterRequest]) (*connect.Response[cksv1beta1.DeleteClusterResponse], error) {
+	resp := &cksv1beta1.DeleteClusterResponse{}
+	found := s.server.clusters.delete(req.Msg.GetId(), func(e *clusterEntry) {
+		e.Status = cksv1beta1.Cluster_STATUS_DELETING
+		e.UpdatedAt = timestamppb.New(s.server.now())
+		resp.Cluster = clone(e.Cluster)
+	})
+	if !found {
+		return nil, notFound(clusterResourceType, req.Msg.GetId())
+	}
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,160 @@
+package fake
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"buf.build/gen/go/coreweave/cwobject/connectrpc/go/cwobject/v1/cwobjectv1connect"
+	cwobjectv1 "buf.build/gen/go/coreweave/cwobject/protocolbuffers/go/cwobject/v1"
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	accessPolicyResourceType = "access policy"
+	bucketResourceType       = "bucket"
+
+	// defaultAccessKeyDuration is the lifetime of access keys created without a duration.
+	defaultAccessKeyDuration = time.Hour
+)
+
+// cwobjectService implements the CWObject service. Access keys are minted but not stored, as the S3 API is not
+// implemented. Bucket settings are accepted for any bucket name, since buckets themselves are created through S3.
+type cwobjectService struct {
+	cwobjectv1connect.UnimplementedCWObjectHandler
+
+	server *Server
+
+	mu       sync.Mutex
+	policies map[string]*cwobjectv1.CWObjectPolicy
+	buckets  map[string]*cwobjectv1.BucketInfo
+}
+
+func newCWObjectService(server *Server) *cwobjectService {
+	return &cwobjectService{
+		server:   server,
+		policies: map[string]*cwobjectv1.CWObjectPolicy{},
+		buckets:  map[string]*cwobjectv1.BucketInfo{},
+	}
+}
+
+func (s *cwobjectService) CreateAccessKeyFromJWT(_ context.Context, req *connect.Request[cwobjectv1.CreateAccessKeyFromJWTRequest]) (*connect.Response[cwobjectv1.CreateAccessKeyFromJWTResponse], error) {
+	duration := defaultAccessKeyDuration
+	if seconds := req.Msg.GetDurationSeconds(); seconds != nil {
+		duration = time.Duration(seconds.GetValue()) * time.Second
+	}
+
+	return connect.NewResponse(&cwobjectv1.CreateAccessKeyFromJWTResponse{
+		AccessKeyId: "CWFAKE" + strings.ToUpper(randomHex(7)),
+		SecretKey:   randomHex(20),
+		Expiry:      timestamppb.New(s.server.now().Add(duration)),
+	}), nil
+}
+
+func (s *cwobjectService) EnsureAccessPolicy(_ context.Context, req *connect.Request[cwobjectv1.EnsureAccessPolicyRequest]) (*connect.Response[cwobjectv1.EnsureAccessPolicyResponse], error) {
+	policy := req.Msg.GetPolicy()
+	if policy.GetName() == "" {
+		return nil, invalidArgument("policy.name", "must not be empty")
+	}
+	if len(policy.GetStatements()) == 0 {
+		return nil, invalidArgument("policy.statements", "must not be empty")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.policies[policy.GetName()] = clone(policy)
+	return connect.NewResponse(&cwobjectv1.EnsureAccessPolicyResponse{Policy: clone(policy)}), nil
+}
+
+func (s *cwobjectService) ListAccessPolicies(_ context.Context, _ *connect.Request[cwobjectv1.ListAccessPoliciesRequest]) (*connect.Response[cwobjectv1.ListAccessPoliciesResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resp := &cwobjectv1.ListAccessPoliciesResponse{}
+	for _, name := range slices.Sorted(maps.Keys(s.policies)) {
+		resp.Policies = append(resp.Policies, clone(s.policies[name]))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *cwobjectService) DeleteAccessPolicy(_ context.Context, req *connect.Request[cwobjectv1.DeleteAccessPolicyRequest]) (*connect.Response[cwobjectv1.DeleteAccessPolicyResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.policies[req.Msg.GetName()]; !ok {
+		return nil, notFound(accessPolicyResourceType, req.Msg.GetName())
+	}
+	delete(s.policies, req.Msg.GetName())
+	return connect.NewResponse(&cwobjectv1.DeleteAccessPolicyResponse{}), nil
+}
+
+func (s *cwobjectService) SetBucketSettings(_ context.Context, req *connect.Request[cwobjectv1.SetBucketSettingsRequest]) (*connect.Response[cwobjectv1.SetBucketSettingsResponse], error) {
+	if req.Msg.GetBucketName() == "" {
+		return nil, invalidArgument("bucket_name", "must not be empty")
+	}
+	if req.Msg.GetSettings() == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("bucket settings are required"))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info, ok := s.buckets[req.Msg.GetBucketName()]
+	if !ok {
+		info = &cwobjectv1.BucketInfo{Name: req.Msg.GetBucketName(), Settings: &cwobjectv1.CWObjectBucketSettings{}}
+		s.buckets[info.GetName()] = info
+	}
+	// only the settings present in the request change
+	settings := req.Msg.GetSettings()
+	if settings.GetAuditLoggingEnabled() != nil {
+		info.Settings.AuditLoggingEnabled = clone(settings.GetAuditLoggingEnabled())
+	}
+	if settings.GetArchiveEnabled() != nil {
+		info.Settings.ArchiveEnabled = clone(settings.GetArchiveEnabled())
+	}
+	if settings.GetArchiveAfterLastAccessDays() != nil {
+		info.Settings.ArchiveAfterLastAccessDays = clone(settings.GetArchiveAfterLastAccessDays())
+	}
+	if enabled := settings.GetArchiveEnabled(); enabled != nil && !enabled.GetValue() {
+		info.Settings.ArchiveAfterLastAccessDays = nil
+	}
+
+	return connect.NewResponse(&cwobjectv1.SetBucketSettingsResponse{Settings: clone(info.GetSettings())}), nil
+}
+
+func (s *cwobjectService) GetBucketInfo(_ context.Context, req *connect.Request[cwobjectv1.GetBucketInfoRequest]) (*connect.Response[cwobjectv1.GetBucketInfoResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info, ok := s.buckets[req.Msg.GetBucketName()]
+	if !ok {
+		return nil, notFound(bucketResourceType, req.Msg.GetBucketName())
+	}
+	return connect.NewResponse(&cwobjectv1.GetBucketInfoResponse{Info: clone(info)}), nil
+}
+
+func (s *cwobjectService) ListBucketInfo(_ context.Context, _ *connect.Request[cwobjectv1.ListBucketInfoRequest]) (*connect.Response[cwobjectv1.ListBucketInfoResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resp := &cwobjectv1.ListBucketInfoResponse{}
+	for _, name := range slices.Sorted(maps.Keys(s.buckets)) {
+		resp.Info = append(resp.Info, clone(s.buckets[name]))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	// crypto/rand does not fail on supported platforms
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
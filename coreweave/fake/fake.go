@@ -0,0 +1,356 @@
+// Package fake implements an in-memory stand-in for the CoreWeave API, for running Terraform configurations that use
+// the provider without a CoreWeave account.
+//
+// It serves the CKS ClusterService, the networking VPCService, the inference Deployment, CapacityClaim and Gateway
+// services, and the CWObject service, and simulates the status transitions of the real API: resources are created,
+// updated and deleted asynchronously, e.g. a cluster is CREATING until the server's delay has passed and RUNNING after.
+// Mutating calls that carry an Idempotency-Key header are only acted on once; repeating one returns the outcome of the
+// first. The Object Storage S3 API is not implemented; use the provider's static S3 credentials with MinIO or another
+// S3-compatible server for that.
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"buf.build/gen/go/coreweave/cks/connectrpc/go/coreweave/cks/v1beta1/cksv1beta1connect"
+	"buf.build/gen/go/coreweave/cwobject/connectrpc/go/cwobject/v1/cwobjectv1connect"
+	"buf.build/gen/go/coreweave/inference/connectrpc/go/coreweave/inference/v1alpha1/inferencev1alpha1connect"
+	"buf.build/gen/go/coreweave/networking/connectrpc/go/coreweave/networking/v1beta1/networkingv1beta1connect"
+	"connectrpc.com/connect"
+	"github.com/hashicorp/go-uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultDelay is how long resources stay in a transitional status, such as CREATING, by default.
+const DefaultDelay = 2 * time.Second
+
+// Server is an in-memory CoreWeave API. The zero value is not usable; create one with New.
+type Server struct {
+	delay   time.Duration
+	now     func() time.Time
+	failing []string
+
+	clusters       *store[*clusterEntry]
+	vpcs           *store[*vpcEntry]
+	deployments    *store[*deploymentEntry]
+	capacityClaims *store[*capacityClaimEntry]
+	gateways       *store[*gatewayEntry]
+	cwobject       *cwobjectService
+
+	idempotentMu    sync.Mutex
+	idempotentCalls map[string]*idempotentCall
+}
+
+// idempotentCall is the outcome of the first call made with an idempotency key.
+type idempotentCall struct {
+	once sync.Once
+	resp connect.AnyResponse
+	err  error
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithDelay sets how long resources stay in a transitional status, such as CREATING or DELETING, before they settle.
+// A delay of zero settles resources the first time they are read after the request that changed them.
+func WithDelay(delay time.Duration) Option {
+	return func(s *Server) {
+		s.delay = delay
+	}
+}
+
+// WithFailingNames makes the clusters and deployments with one of the given names fail rather than settle: they end in
+// the FAILED status once their transition is over, as they would if the real API could not create or update them.
+func WithFailingNames(names ...string) Option {
+	return func(s *Server) {
+		s.failing = append(s.failing, names...)
+	}
+}
+
+// New returns an empty Server.
+func New(opts ...Option) *Server {
+	s := &Server{
+		delay:           DefaultDelay,
+		now:             time.Now,
+		idempotentCalls: map[string]*idempotentCall{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.clusters = newStore[*clusterEntry](s)
+	s.vpcs = newStore[*vpcEntry](s)
+	s.deployments = newStore[*deploymentEntry](s)
+	s.capacityClaims = newStore[*capacityClaimEntry](s)
+	s.gateways = newStore[*gatewayEntry](s)
+	s.cwobject = newCWObjectService(s)
+
+	return s
+}
+
+// Handler returns an http.Handler serving every service of the fake API. Requests must carry a bearer token, as they do
+// with the real API, but any token is accepted.
+func (s *Server) Handler() http.Handler {
+	opts := connect.WithInterceptors(requireBearerToken(), s.deduplicateByIdempotencyKey())
+
+	mux := http.NewServeMux()
+	mux.Handle(cksv1beta1connect.NewClusterServiceHandler(&clusterService{server: s}, opts))
+	mux.Handle(networkingv1beta1connect.NewVPCServiceHandler(&vpcService{server: s}, opts))
+	mux.Handle(inferencev1alpha1connect.NewDeploymentServiceHandler(&deploymentService{server: s}, opts))
+	mux.Handle(inferencev1alpha1connect.NewCapacityClaimServiceHandler(&capacityClaimService{server: s}, opts))
+	mux.Handle(inferencev1alpha1connect.NewGatewayServiceHandler(&gatewayService{server: s}, opts))
+	mux.Handle(cwobjectv1connect.NewCWObjectHandler(s.cwobject, opts))
+	return mux
+}
+
+func requireBearerToken() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			token, ok := strings.CutPrefix(req.Header().Get("Authorization"), "Bearer ")
+			if !ok || strings.TrimSpace(token) == "" {
+				return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("a bearer token is required"))
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// deduplicateByIdempotencyKey returns the outcome of the first call with the same procedure and Idempotency-Key header
+// for every later one, as a retried request must not be acted on twice.
+func (s *Server) deduplicateByIdempotencyKey() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			key := req.Header().Get("Idempotency-Key")
+			if key == "" {
+				return next(ctx, req)
+			}
+
+			s.idempotentMu.Lock()
+			call, ok := s.idempotentCalls[req.Spec().Procedure+" "+key]
+			if !ok {
+				call = &idempotentCall{}
+				s.idempotentCalls[req.Spec().Procedure+" "+key] = call
+			}
+			s.idempotentMu.Unlock()
+
+			call.once.Do(func() {
+				call.resp, call.err = next(ctx, req)
+			})
+			return call.resp, call.err
+		}
+	}
+}
+
+func newID() string {
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		// crypto/rand does not fail on supported platforms
+		panic(err)
+	}
+	return id
+}
+
+// entry is a resource held by a store. Its methods are only called with the store's lock held.
+type entry interface {
+	// id returns the resource's ID.
+	id() string
+	// settle moves the resource from its transitional status to the status it settles in.
+	settle(now time.Time)
+}
+
+// failingEntry is an entry that can fail rather than settle, see WithFailingNames.
+type failingEntry interface {
+	entry
+	// name returns the resource's name.
+	name() string
+	// fail moves the resource from its transitional status to a failed status.
+	fail(now time.Time)
+}
+
+// record tracks the transition of an entry.
+type record[E entry] struct {
+	entry E
+	// pending is set while the resource is in a transitional status, until settleAt.
+	pending  bool
+	deleting bool
+	settleAt time.Time
+}
+
+// store holds the resources of one type in creation order, and settles their transitions as they are read.
+type store[E entry] struct {
+	server *Server
+
+	mu      sync.Mutex
+	ids     []string
+	records map[string]*record[E]
+}
+
+func newStore[E entry](server *Server) *store[E] {
+	return &store[E]{server: server, records: map[string]*record[E]{}}
+}
+
+// advance settles the record for id if its transition is complete, removing it if it was being deleted. It returns
+// false if there is no such record.
+func (s *store[E]) advance(id string) (*record[E], bool) {
+	r, ok := s.records[id]
+	if !ok {
+		return nil, false
+	}
+	if !r.pending || s.server.now().Before(r.settleAt) {
+		return r, true
+	}
+
+	if r.deleting {
+		delete(s.records, id)
+		s.ids = slices.DeleteFunc(s.ids, func(existing string) bool { return existing == id })
+		return nil, false
+	}
+	r.pending = false
+	if failing, ok := any(r.entry).(failingEntry); ok && slices.Contains(s.server.failing, failing.name()) {
+		failing.fail(s.server.now())
+		return r, true
+	}
+	r.entry.settle(s.server.now())
+	return r, true
+}
+
+func (s *store[E]) startTransition(r *record[E]) {
+	r.pending = true
+	r.settleAt = s.server.now().Add(s.server.delay)
+}
+
+// create adds e in its transitional status. It fails if conflict returns true for an existing resource.
+func (s *store[E]) create(e E, conflict func(existing E) bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, id := range slices.Clone(s.ids) {
+		if r, ok := s.advance(id); ok && !r.deleting && conflict(r.entry) {
+			return errAlreadyExists
+		}
+	}
+
+	r := &record[E]{entry: e}
+	s.startTransition(r)
+	s.records[e.id()] = r
+	s.ids = append(s.ids, e.id())
+	return nil
+}
+
+// get calls fn with the resource with the given id, returning false if there is none.
+func (s *store[E]) get(id string, fn func(E)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.advance(id)
+	if ok {
+		fn(r.entry)
+	}
+	return ok
+}
+
+// list calls fn with every resource, in creation order.
+func (s *store[E]) list(fn func(E)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, id := range slices.Clone(s.ids) {
+		if r, ok := s.advance(id); ok {
+			fn(r.entry)
+		}
+	}
+}
+
+// update calls fn with the resource with the given id and starts a transition if fn succeeds. It returns false if
+// there is no such resource.
+func (s *store[E]) update(id string, fn func(E) error) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.advance(id)
+	if !ok {
+		return false, nil
+	}
+	if r.deleting {
+		return true, errDeleting
+	}
+	if err := fn(r.entry); err != nil {
+		return true, err
+	}
+	s.startTransition(r)
+	return true, nil
+}
+
+// delete calls fn with the resource with the given id and starts its deletion. It returns false if there is no such
+// resource.
+func (s *store[E]) delete(id string, fn func(E)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.advance(id)
+	if !ok {
+		return false
+	}
+	if !r.deleting {
+		r.deleting = true
+		s.startTransition(r)
+	}
+	fn(r.entry)
+	return true
+}
+
+var (
+	errAlreadyExists = errors.New("already exists")
+	errDeleting      = errors.New("is being deleted")
+)
+
+// apiError converts the errors returned by store methods into Connect errors for the named resource.
+func apiError(resourceType, name string, err error) error {
+	switch {
+	case errors.Is(err, errAlreadyExists):
+		return withResourceInfo(connect.CodeAlreadyExists, resourceType, name, fmt.Sprintf("a %s named %q already exists", resourceType, name))
+	case errors.Is(err, errDeleting):
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s %q is being deleted", resourceType, name))
+	default:
+		return err
+	}
+}
+
+func notFound(resourceType, id string) error {
+	return withResourceInfo(connect.CodeNotFound, resourceType, id, fmt.Sprintf("%s %q does not exist", resourceType, id))
+}
+
+func withResourceInfo(code connect.Code, resourceType, name, description string) error {
+	err := connect.NewError(code, errors.New(description))
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.ResourceInfo{
+		ResourceType: resourceType,
+		ResourceName: name,
+		Description:  description,
+	}); detailErr == nil {
+		err.AddDetail(detail)
+	}
+	return err
+}
+
+// invalidArgument returns an InvalidArgument error with a BadRequest field violation for field.
+func invalidArgument(field, description string) error {
+	err := connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s: %s", field, description))
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: description}},
+	}); detailErr == nil {
+		err.AddDetail(detail)
+	}
+	return err
+}
+
+func clone[M proto.Message](m M) M {
+	return proto.Clone(m).(M)
+}
@@ -0,0 +1,354 @@
+package fake
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"buf.build/gen/go/coreweave/cks/connectrpc/go/coreweave/cks/v1beta1/cksv1beta1connect"
+	cksv1beta1 "buf.build/gen/go/coreweave/cks/protocolbuffers/go/coreweave/cks/v1beta1"
+	"buf.build/gen/go/coreweave/cwobject/connectrpc/go/cwobject/v1/cwobjectv1connect"
+	cwobjectv1 "buf.build/gen/go/coreweave/cwobject/protocolbuffers/go/cwobject/v1"
+	"buf.build/gen/go/coreweave/inference/connectrpc/go/coreweave/inference/v1alpha1/inferencev1alpha1connect"
+	inferencev1alpha1 "buf.build/gen/go/coreweave/inference/protocolbuffers/go/coreweave/inference/v1alpha1"
+	"buf.build/gen/go/coreweave/networking/connectrpc/go/coreweave/networking/v1beta1/networkingv1beta1connect"
+	networkingv1beta1 "buf.build/gen/go/coreweave/networking/protocolbuffers/go/coreweave/networking/v1beta1"
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// testClock is a manually advanced clock for the server.
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+// newTestServer starts a fake server with the given options whose clock only moves when advanced, and returns its URL.
+func newTestServer(t *testing.T, opts ...Option) (string, *testClock) {
+	t.Helper()
+
+	clock := &testClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	s := New(append([]Option{WithDelay(time.Minute)}, opts...)...)
+	s.now = clock.Now
+
+	srv := httptest.NewServer(s.Handler())
+	t.Cleanup(srv.Close)
+
+	return srv.URL, clock
+}
+
+func withToken() connect.ClientOption {
+	return connect.WithInterceptors(connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			req.Header().Set("Authorization", "Bearer test-token")
+			return next(ctx, req)
+		}
+	}))
+}
+
+func requireCode(t *testing.T, err error, code connect.Code) *connect.Error {
+	t.Helper()
+
+	var connectErr *connect.Error
+	require.ErrorAs(t, err, &connectErr)
+	require.Equal(t, code, connectErr.Code(), connectErr.Error())
+	return connectErr
+}
+
+func createTestVPC(t *testing.T, client networkingv1beta1connect.VPCServiceClient, clock *testClock) *networkingv1beta1.VPC {
+	t.Helper()
+
+	resp, err := client.CreateVPC(t.Context(), connect.NewRequest(&networkingv1beta1.CreateVPCRequest{
+		Name: "test-vpc",
+		Zone: "US-EAST-04A",
+		VpcPrefixes: []*networkingv1beta1.Prefix{
+			{Name: "pod-cidr", Value: "10.0.0.0/16"},
+		},
+	}))
+	require.NoError(t, err)
+	clock.Advance(time.Minute)
+
+	return resp.Msg.GetVpc()
+}
+
+func TestServer_RequiresToken(t *testing.T) {
+	url, _ := newTestServer(t)
+
+	client := cksv1beta1connect.NewClusterServiceClient(http.DefaultClient, url)
+	_, err := client.ListClusters(t.Context(), connect.NewRequest(&cksv1beta1.ListClustersRequest{}))
+	requireCode(t, err, connect.CodeUnauthenticated)
+}
+
+func TestClusterService_Lifecycle(t *testing.T) {
+	url, clock := newTestServer(t)
+	vpc := createTestVPC(t, networkingv1beta1connect.NewVPCServiceClient(http.DefaultClient, url, withToken()), clock)
+	client := cksv1beta1connect.NewClusterServiceClient(http.DefaultClient, url, withToken())
+	ctx := t.Context()
+
+	created, err := client.CreateCluster(ctx, connect.NewRequest(&cksv1beta1.CreateClusterRequest{
+		Name:    "test-cluster",
+		Zone:    "US-EAST-04A",
+		VpcId:   vpc.GetId(),
+		Version: "v1.32",
+		Network: &cksv1beta1.ClusterNetworkConfig{
+			PodCidrName:         "pod-cidr",
+			InternalLbCidrNames: []string{"lb-a"},
+		},
+	}))
+	require.NoError(t, err)
+	cluster := created.Msg.GetCluster()
+	assert.NotEmpty(t, cluster.GetId())
+	assert.Equal(t, cksv1beta1.Cluster_STATUS_CREATING, cluster.GetStatus())
+	assert.Equal(t, "pod-cidr", cluster.GetNetwork().GetPodCidrName())
+
+	got, err := client.GetCluster(ctx, connect.NewRequest(&cksv1beta1.GetClusterRequest{Id: cluster.GetId()}))
+	require.NoError(t, err)
+	assert.Equal(t, cksv1beta1.Cluster_STATUS_CREATING, got.Msg.GetCluster().GetStatus())
+
+	clock.Advance(time.Minute)
+	got, err = client.GetCluster(ctx, connect.NewRequest(&cksv1beta1.GetClusterRequest{Id: cluster.GetId()}))
+	require.NoError(t, err)
+	assert.Equal(t, cksv1beta1.Cluster_STATUS_RUNNING, got.Msg.GetCluster().GetStatus())
+	assert.NotEmpty(t, got.Msg.GetCluster().GetApiServerEndpoint())
+
+	// only the masked fields change
+	updated, err := client.UpdateCluster(ctx, connect.NewRequest(&cksv1beta1.UpdateClusterRequest{
+		Id:         cluster.GetId(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"public", "network.internal_lb_cidr_names"}},
+		Public:     true,
+		Version:    "v1.99",
+		Network: &cksv1beta1.UpdateClusterRequest_Network{
+			InternalLbCidrNames: []string{"lb-b", "lb-c"},
+		},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, cksv1beta1.Cluster_STATUS_UPDATING, updated.Msg.GetCluster().GetStatus())
+	assert.True(t, updated.Msg.GetCluster().GetPublic())
+	assert.Equal(t, "v1.32", updated.Msg.GetCluster().GetVersion())
+	assert.Equal(t, []string{"lb-b", "lb-c"}, updated.Msg.GetCluster().GetNetwork().GetInternalLbCidrNames())
+	assert.Equal(t, "pod-cidr", updated.Msg.GetCluster().GetNetwork().GetPodCidrName())
+
+	clock.Advance(time.Minute)
+	listed, err := client.ListClusters(ctx, connect.NewRequest(&cksv1beta1.ListClustersRequest{}))
+	require.NoError(t, err)
+	require.Len(t, listed.Msg.GetItems(), 1)
+	assert.Equal(t, cksv1beta1.Cluster_STATUS_RUNNING, listed.Msg.GetItems()[0].GetStatus())
+
+	deleted, err := client.DeleteCluster(ctx, connect.NewRequest(&cksv1beta1.DeleteClusterRequest{Id: cluster.GetId()}))
+	require.NoError(t, err)
+	assert.Equal(t, cksv1beta1.Cluster_STATUS_DELETING, deleted.Msg.GetCluster().GetStatus())
+
+	_, err = client.UpdateCluster(ctx, connect.NewRequest(&cksv1beta1.UpdateClusterRequest{Id: cluster.GetId()}))
+	requireCode(t, err, connect.CodeFailedPrecondition)
+
+	clock.Advance(time.Minute)
+	_, err = client.GetCluster(ctx, connect.NewRequest(&cksv1beta1.GetClusterRequest{Id: cluster.GetId()}))
+	requireCode(t, err, connect.CodeNotFound)
+}
+
+func TestServer_WithFailingNames(t *testing.T) {
+	url, clock := newTestServer(t, WithFailingNames("broken"))
+	vpc := createTestVPC(t, networkingv1beta1connect.NewVPCServiceClient(http.DefaultClient, url, withToken()), clock)
+	client := cksv1beta1connect.NewClusterServiceClient(http.DefaultClient, url, withToken())
+	ctx := t.Context()
+
+	for _, name := range []string{"broken", "working"} {
+		created, err := client.CreateCluster(ctx, connect.NewRequest(&cksv1beta1.CreateClusterRequest{Name: name, Zone: "US-EAST-04A", VpcId: vpc.GetId()}))
+		require.NoError(t, err)
+		assert.Equal(t, cksv1beta1.Cluster_STATUS_CREATING, created.Msg.GetCluster().GetStatus())
+	}
+
+	clock.Advance(time.Minute)
+	list, err := client.ListClusters(ctx, connect.NewRequest(&cksv1beta1.ListClustersRequest{}))
+	require.NoError(t, err)
+	got := map[string]cksv1beta1.Cluster_Status{}
+	for _, cluster := range list.Msg.GetItems() {
+		got[cluster.GetName()] = cluster.GetStatus()
+	}
+	assert.Equal(t, map[string]cksv1beta1.Cluster_Status{
+		"broken":  cksv1beta1.Cluster_STATUS_FAILED,
+		"working": cksv1beta1.Cluster_STATUS_RUNNING,
+	}, got)
+}
+
+func TestClusterService_CreateErrors(t *testing.T) {
+	url, clock := newTestServer(t)
+	vpc := createTestVPC(t, networkingv1beta1connect.NewVPCServiceClient(http.DefaultClient, url, withToken()), clock)
+	client := cksv1beta1connect.NewClusterServiceClient(http.DefaultClient, url, withToken())
+
+	_, err := client.CreateCluster(t.Context(), connect.NewRequest(&cksv1beta1.CreateClusterRequest{
+		Name:  "test-cluster",
+		Zone:  "US-EAST-04A",
+		VpcId: "does-not-exist",
+	}))
+	connectErr := requireCode(t, err, connect.CodeInvalidArgument)
+	require.Len(t, connectErr.Details(), 1)
+	detail, err := connectErr.Details()[0].Value()
+	require.NoError(t, err)
+	badRequest, ok := detail.(*errdetails.BadRequest)
+	require.True(t, ok, "unexpected detail %T", detail)
+	assert.Equal(t, "vpc_id", badRequest.GetFieldViolations()[0].GetField())
+
+	req := &cksv1beta1.CreateClusterRequest{Name: "test-cluster", Zone: "US-EAST-04A", VpcId: vpc.GetId()}
+	_, err = client.CreateCluster(t.Context(), connect.NewRequest(req))
+	require.NoError(t, err)
+
+	_, err = client.CreateCluster(t.Context(), connect.NewRequest(req))
+	connectErr = requireCode(t, err, connect.CodeAlreadyExists)
+	require.Len(t, connectErr.Details(), 1)
+	detail, err = connectErr.Details()[0].Value()
+	require.NoError(t, err)
+	resourceInfo, ok := detail.(*errdetails.ResourceInfo)
+	require.True(t, ok, "unexpected detail %T", detail)
+	assert.Equal(t, "test-cluster", resourceInfo.GetResourceName())
+}
+
+func TestVPCService_Update(t *testing.T) {
+	url, clock := newTestServer(t)
+	client := networkingv1beta1connect.NewVPCServiceClient(http.DefaultClient, url, withToken())
+	vpc := createTestVPC(t, client, clock)
+
+	got, err := client.GetVPC(t.Context(), connect.NewRequest(&networkingv1beta1.GetVPCRequest{Id: vpc.GetId()}))
+	require.NoError(t, err)
+	assert.Equal(t, networkingv1beta1.VPC_STATUS_READY, got.Msg.GetVpc().GetStatus())
+
+	updated, err := client.UpdateVPC(t.Context(), connect.NewRequest(&networkingv1beta1.UpdateVPCRequest{
+		Id:          vpc.GetId(),
+		VpcPrefixes: []*networkingv1beta1.Prefix{{Name: "other", Value: "10.1.0.0/16"}},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, networkingv1beta1.VPC_STATUS_UPDATING, updated.Msg.GetVpc().GetStatus())
+	assert.Equal(t, "test-vpc", updated.Msg.GetVpc().GetName())
+	require.Len(t, updated.Msg.GetVpc().GetVpcPrefixes(), 1)
+	assert.Equal(t, "other", updated.Msg.GetVpc().GetVpcPrefixes()[0].GetName())
+}
+
+func TestInferenceServices(t *testing.T) {
+	url, clock := newTestServer(t)
+	gateways := inferencev1alpha1connect.NewGatewayServiceClient(http.DefaultClient, url, withToken())
+	deployments := inferencev1alpha1connect.NewDeploymentServiceClient(http.DefaultClient, url, withToken())
+	ctx := t.Context()
+
+	gateway, err := gateways.CreateGateway(ctx, connect.NewRequest(&inferencev1alpha1.CreateGatewayRequest{
+		Name:  "test-gateway",
+		Zones: []string{"US-EAST-04A"},
+		Auth:  &inferencev1alpha1.CreateGatewayRequest_CoreWeaveAuth{CoreWeaveAuth: &inferencev1alpha1.CoreWeaveAuth{}},
+	}))
+	require.NoError(t, err)
+	gatewayID := gateway.Msg.GetGateway().GetSpec().GetId()
+	assert.NotEmpty(t, gatewayID)
+	assert.NotNil(t, gateway.Msg.GetGateway().GetSpec().GetCoreWeaveAuth())
+
+	_, err = deployments.CreateDeployment(ctx, connect.NewRequest(&inferencev1alpha1.CreateDeploymentRequest{
+		Name:       "test-deployment",
+		GatewayIds: []string{"does-not-exist"},
+	}))
+	requireCode(t, err, connect.CodeInvalidArgument)
+
+	deployment, err := deployments.CreateDeployment(ctx, connect.NewRequest(&inferencev1alpha1.CreateDeploymentRequest{
+		Id:         "my-deployment",
+		Name:       "test-deployment",
+		GatewayIds: []string{gatewayID},
+		Resources:  &inferencev1alpha1.DeploymentResources{InstanceType: "gd-1xh100", GpuCount: 1},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "my-deployment", deployment.Msg.GetDeployment().GetSpec().GetId())
+	assert.Equal(t, inferencev1alpha1.Status_STATUS_CREATING, deployment.Msg.GetDeployment().GetStatus().GetStatus())
+
+	clock.Advance(time.Minute)
+
+	gotGateway, err := gateways.GetGateway(ctx, connect.NewRequest(&inferencev1alpha1.GetGatewayRequest{Id: gatewayID}))
+	require.NoError(t, err)
+	assert.Equal(t, inferencev1alpha1.Status_STATUS_READY, gotGateway.Msg.GetGateway().GetStatus().GetStatus())
+	assert.NotEmpty(t, gotGateway.Msg.GetGateway().GetStatus().GetEndpoints())
+
+	listed, err := deployments.ListDeployments(ctx, connect.NewRequest(&inferencev1alpha1.ListDeploymentsRequest{ParentGatewayId: gatewayID}))
+	require.NoError(t, err)
+	require.Len(t, listed.Msg.GetItems(), 1)
+	assert.Equal(t, inferencev1alpha1.Status_STATUS_READY, listed.Msg.GetItems()[0].GetStatus().GetStatus())
+
+	// updates replace the spec
+	updated, err := deployments.UpdateDeployment(ctx, connect.NewRequest(&inferencev1alpha1.UpdateDeploymentRequest{
+		Id:         "my-deployment",
+		Name:       "test-deployment",
+		GatewayIds: []string{gatewayID},
+		Disabled:   true,
+	}))
+	require.NoError(t, err)
+	assert.True(t, updated.Msg.GetDeployment().GetSpec().GetDisabled())
+	assert.Nil(t, updated.Msg.GetDeployment().GetSpec().GetResources())
+	assert.Equal(t, inferencev1alpha1.Status_STATUS_UPDATING, updated.Msg.GetDeployment().GetStatus().GetStatus())
+}
+
+func TestCWObjectService(t *testing.T) {
+	url, _ := newTestServer(t)
+	client := cwobjectv1connect.NewCWObjectClient(http.DefaultClient, url, withToken())
+	ctx := t.Context()
+
+	key, err := client.CreateAccessKeyFromJWT(ctx, connect.NewRequest(&cwobjectv1.CreateAccessKeyFromJWTRequest{
+		DurationSeconds: wrapperspb.UInt32(60),
+	}))
+	require.NoError(t, err)
+	assert.NotEmpty(t, key.Msg.GetAccessKeyId())
+	assert.NotEmpty(t, key.Msg.GetSecretKey())
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 1, 0, 0, time.UTC), key.Msg.GetExpiry().AsTime())
+
+	_, err = client.GetBucketInfo(ctx, connect.NewRequest(&cwobjectv1.GetBucketInfoRequest{BucketName: "bucket"}))
+	requireCode(t, err, connect.CodeNotFound)
+
+	_, err = client.SetBucketSettings(ctx, connect.NewRequest(&cwobjectv1.SetBucketSettingsRequest{
+		BucketName: "bucket",
+		Settings: &cwobjectv1.CWObjectBucketSettings{
+			AuditLoggingEnabled:        wrapperspb.Bool(true),
+			ArchiveEnabled:             wrapperspb.Bool(true),
+			ArchiveAfterLastAccessDays: wrapperspb.Int32(90),
+		},
+	}))
+	require.NoError(t, err)
+
+	_, err = client.SetBucketSettings(ctx, connect.NewRequest(&cwobjectv1.SetBucketSettingsRequest{
+		BucketName: "bucket",
+		Settings:   &cwobjectv1.CWObjectBucketSettings{ArchiveEnabled: wrapperspb.Bool(false)},
+	}))
+	require.NoError(t, err)
+
+	info, err := client.GetBucketInfo(ctx, connect.NewRequest(&cwobjectv1.GetBucketInfoRequest{BucketName: "bucket"}))
+	require.NoError(t, err)
+	settings := info.Msg.GetInfo().GetSettings()
+	assert.True(t, settings.GetAuditLoggingEnabled().GetValue())
+	assert.False(t, settings.GetArchiveEnabled().GetValue())
+	assert.Nil(t, settings.GetArchiveAfterLastAccessDays())
+
+	_, err = client.EnsureAccessPolicy(ctx, connect.NewRequest(&cwobjectv1.EnsureAccessPolicyRequest{
+		Policy: &cwobjectv1.CWObjectPolicy{
+			Name:    "policy",
+			Version: "v1alpha1",
+			Statements: []*cwobjectv1.CWObjectPolicyStatement{
+				{Name: "allow", Effect: "Allow", Actions: []string{"s3:*"}, Resources: []string{"*"}, Principals: []string{"*"}},
+			},
+		},
+	}))
+	require.NoError(t, err)
+
+	policies, err := client.ListAccessPolicies(ctx, connect.NewRequest(&cwobjectv1.ListAccessPoliciesRequest{}))
+	require.NoError(t, err)
+	require.Len(t, policies.Msg.GetPolicies(), 1)
+
+	_, err = client.DeleteAccessPolicy(ctx, connect.NewRequest(&cwobjectv1.DeleteAccessPolicyRequest{Name: "policy"}))
+	require.NoError(t, err)
+	_, err = client.DeleteAccessPolicy(ctx, connect.NewRequest(&cwobjectv1.DeleteAccessPolicyRequest{Name: "policy"}))
+	requireCode(t, err, connect.CodeNotFound)
+}
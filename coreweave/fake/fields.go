@@ -0,0 +1,98 @@
+package fake
+
+import (
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// copyFields copies every field of src onto the field of dst with the same name, except the skipped ones. Fields that
+// are unset in src are cleared in dst, and fields of src that dst does not have are ignored. Message fields of different
+// types, such as a request's sub-message and the resource's, are copied field by field.
+//
+// This lets a request message be applied to the resource it creates or updates, as their fields share names.
+func copyFields(dst, src protoreflect.Message, skip ...protoreflect.Name) {
+	fields := src.Descriptor().Fields()
+	for i := range fields.Len() {
+		srcField := fields.Get(i)
+		if slices.Contains(skip, srcField.Name()) {
+			continue
+		}
+		dstField := dst.Descriptor().Fields().ByName(srcField.Name())
+		if dstField == nil {
+			continue
+		}
+		copyField(dst, dstField, src, srcField)
+	}
+}
+
+// applyMask copies the fields of src named by the paths of a google.protobuf.FieldMask onto dst. A path into a
+// sub-message, e.g. "network.internal_lb_cidr_names", copies only that field of the sub-message.
+func applyMask(dst, src protoreflect.Message, paths []string) {
+	for _, p := range paths {
+		name, rest, nested := strings.Cut(p, ".")
+
+		srcField := src.Descriptor().Fields().ByName(protoreflect.Name(name))
+		dstField := dst.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if srcField == nil || dstField == nil {
+			continue
+		}
+
+		if nested && srcField.Message() != nil && dstField.Message() != nil && !srcField.IsList() && !srcField.IsMap() {
+			applyMask(dst.Mutable(dstField).Message(), src.Get(srcField).Message(), []string{rest})
+			continue
+		}
+		copyField(dst, dstField, src, srcField)
+	}
+}
+
+func copyField(dst protoreflect.Message, dstField protoreflect.FieldDescriptor, src protoreflect.Message, srcField protoreflect.FieldDescriptor) {
+	if !compatible(dstField, srcField) {
+		return
+	}
+	dst.Clear(dstField)
+	if !src.Has(srcField) {
+		return
+	}
+
+	value := src.Get(srcField)
+	switch {
+	case srcField.IsList():
+		list := dst.Mutable(dstField).List()
+		for i := range value.List().Len() {
+			list.Append(copyValue(list.NewElement, value.List().Get(i), srcField))
+		}
+	case srcField.IsMap():
+		m := dst.Mutable(dstField).Map()
+		value.Map().Range(func(key protoreflect.MapKey, v protoreflect.Value) bool {
+			m.Set(key, copyValue(m.NewValue, v, srcField.MapValue()))
+			return true
+		})
+	case srcField.Message() != nil:
+		copyFields(dst.Mutable(dstField).Message(), value.Message())
+	default:
+		dst.Set(dstField, value)
+	}
+}
+
+// copyValue copies a list element or map value. Messages are copied into a new value from newValue.
+func copyValue(newValue func() protoreflect.Value, value protoreflect.Value, field protoreflect.FieldDescriptor) protoreflect.Value {
+	if field.Message() == nil {
+		return value
+	}
+	copied := newValue()
+	copyFields(copied.Message(), value.Message())
+	return copied
+}
+
+// compatible reports whether a value of the src field can be stored in the dst field.
+func compatible(dst, src protoreflect.FieldDescriptor) bool {
+	if dst.Kind() != src.Kind() || dst.IsList() != src.IsList() || dst.IsMap() != src.IsMap() {
+		return false
+	}
+	if src.IsMap() {
+		return compatible(dst.MapKey(), src.MapKey()) && compatible(dst.MapValue(), src.MapValue())
+	}
+	return true
+}
@@ -0,0 +1,337 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"buf.build/gen/go/coreweave/inference/connectrpc/go/coreweave/inference/v1alpha1/inferencev1alpha1connect"
+	inferencev1alpha1 "buf.build/gen/go/coreweave/inference/protocolbuffers/go/coreweave/inference/v1alpha1"
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	deploymentResourceType    = "deployment"
+	capacityClaimResourceType = "capacity claim"
+	gatewayResourceType       = "gateway"
+)
+
+// newSpec applies a create request to an empty spec, generating an ID unless the request sets one.
+func newSpec[S interface {
+	protoreflect.ProtoMessage
+	GetId() string
+	SetId(string)
+}](spec S, req protoreflect.ProtoMessage) S {
+	copyFields(spec.ProtoReflect(), req.ProtoReflect())
+	if spec.GetId() == "" {
+		spec.SetId(newID())
+	}
+	return spec
+}
+
+type deploymentEntry struct {
+	*inferencev1alpha1.Deployment
+}
+
+func (e *deploymentEntry) id() string {
+	return e.GetSpec().GetId()
+}
+
+func (e *deploymentEntry) settle(now time.Time) {
+	e.Status.Status = inferencev1alpha1.Status_STATUS_READY
+	e.Status.UpdatedAt = timestamppb.New(now)
+}
+
+func (e *deploymentEntry) name() string {
+	return e.GetSpec().GetName()
+}
+
+func (e *deploymentEntry) fail(now time.Time) {
+	e.Status.Status = inferencev1alpha1.Status_STATUS_FAILED
+	e.Status.UpdatedAt = timestamppb.New(now)
+}
+
+type deploymentService struct {
+	inferencev1alpha1connect.UnimplementedDeploymentServiceHandler
+
+	server *Server
+}
+
+func (s *deploymentService) ListDeployments(_ context.Context, req *connect.Request[inferencev1alpha1.ListDeploymentsRequest]) (*connect.Response[inferencev1alpha1.ListDeploymentsResponse], error) {
+	resp := &inferencev1alpha1.ListDeploymentsResponse{}
+	s.server.deployments.list(func(e *deploymentEntry) {
+		if gatewayID := req.Msg.GetParentGatewayId(); gatewayID != "" && !slices.Contains(e.GetSpec().GetGatewayIds(), gatewayID) {
+			return
+		}
+		resp.Items = append(resp.Items, clone(e.Deployment))
+	})
+	return connect.NewResponse(resp), nil
+}
+
+func (s *deploymentService) GetDeployment(_ context.Context, req *connect.Request[inferencev1alpha1.GetDeploymentRequest]) (*connect.Response[inferencev1alpha1.GetDeploymentResponse], error) {
+	resp := &inferencev1alpha1.GetDeploymentResponse{}
+	if !s.server.deployments.get(req.Msg.GetId(), func(e *deploymentEntry) { resp.Deployment = clone(e.Deployment) }) {
+		return nil, notFound(deploymentResourceType, req.Msg.GetId())
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *deploymentService) CreateDeployment(_ context.Context, req *connect.Request[inferencev1alpha1.CreateDeploymentRequest]) (*connect.Response[inferencev1alpha1.CreateDeploymentResponse], error) {
+	if req.Msg.GetName() == "" {
+		return nil, invalidArgument("name", "must not be empty")
+	}
+	if err := s.validateGateways(req.Msg.GetGatewayIds()); err != nil {
+		return nil, err
+	}
+
+	now := timestamppb.New(s.server.now())
+	deployment := &inferencev1alpha1.Deployment{
+		Spec: newSpec(&inferencev1alpha1.DeploymentSpec{}, req.Msg),
+		Status: &inferencev1alpha1.DeploymentStatus{
+			Status:    inferencev1alpha1.Status_STATUS_CREATING,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+
+	err := s.server.deployments.create(&deploymentEntry{deployment}, func(existing *deploymentEntry) bool {
+		return existing.id() == deployment.GetSpec().GetId() || existing.GetSpec().GetName() == deployment.GetSpec().GetName()
+	})
+	if err != nil {
+		return nil, apiError(deploymentResourceType, deployment.GetSpec().GetName(), err)
+	}
+	return connect.NewResponse(&inferencev1alpha1.CreateDeploymentResponse{Deployment: clone(deployment)}), nil
+}
+
+func (s *deploymentService) UpdateDeployment(_ context.Context, req *connect.Request[inferencev1alpha1.UpdateDeploymentRequest]) (*connect.Response[inferencev1alpha1.UpdateDeploymentResponse], error) {
+	if err := s.validateGateways(req.Msg.GetGatewayIds()); err != nil {
+		return nil, err
+	}
+
+	resp := &inferencev1alpha1.UpdateDeploymentResponse{}
+	found, err := s.server.deployments.update(req.Msg.GetId(), func(e *deploymentEntry) error {
+		copyFields(e.GetSpec().ProtoReflect(), req.Msg.ProtoReflect(), "id")
+		e.Status.Status = inferencev1alpha1.Status_STATUS_UPDATING
+		e.Status.UpdatedAt = timestamppb.New(s.server.now())
+		resp.Deployment = clone(e.Deployment)
+		return nil
+	})
+	if !found {
+		return nil, notFound(deploymentResourceType, req.Msg.GetId())
+	}
+	if err != nil {
+		return nil, apiError(deploymentResourceType, req.Msg.GetId(), err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *deploymentService) DeleteDeployment(_ context.Context, req *connect.Request[inferencev1alpha1.DeleteDeploymentRequest]) (*connect.Response[inferencev1alpha1.DeleteDeploymentResponse], error) {
+	resp := &inferencev1alpha1.DeleteDeploymentResponse{}
+	found := s.server.deployments.delete(req.Msg.GetId(), func(e *deploymentEntry) {
+		e.Status.Status = inferencev1alpha1.Status_STATUS_DELETING
+		e.Status.UpdatedAt = timestamppb.New(s.server.now())
+		resp.Deployment = clone(e.Deployment)
+	})
+	if !found {
+		return nil, notFound(deploymentResourceType, req.Msg.GetId())
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// validateGateways checks that every gateway a deployment is attached to exists.
+func (s *deploymentService) validateGateways(ids []string) error {
+	for i, id := range ids {
+		if !s.server.gateways.get(id, func(*gatewayEntry) {}) {
+			return invalidArgument(fmt.Sprintf("gateway_ids[%d]", i), fmt.Sprintf("gateway %q does not exist", id))
+		}
+	}
+	return nil
+}
+
+type capacityClaimEntry struct {
+	*inferencev1alpha1.CapacityClaim
+}
+
+func (e *capacityClaimEntry) id() string {
+	return e.GetSpec().GetId()
+}
+
+func (e *capacityClaimEntry) settle(now time.Time) {
+	e.Status.Status = inferencev1alpha1.Status_STATUS_READY
+	e.Status.UpdatedAt = timestamppb.New(now)
+	e.Status.AllocatedInstances = e.GetSpec().GetResources().GetInstanceCount()
+	e.Status.PendingInstances = 0
+}
+
+type capacityClaimService struct {
+	inferencev1alpha1connect.UnimplementedCapacityClaimServiceHandler
+
+	server *Server
+}
+
+func (s *capacityClaimService) ListCapacityClaims(_ context.Context, _ *connect.Request[inferencev1alpha1.ListCapacityClaimsRequest]) (*connect.Response[inferencev1alpha1.ListCapacityClaimsResponse], error) {
+	resp := &inferencev1alpha1.ListCapacityClaimsResponse{}
+	s.server.capacityClaims.list(func(e *capacityClaimEntry) {
+		resp.CapacityClaims = append(resp.CapacityClaims, clone(e.CapacityClaim))
+	})
+	return connect.NewResponse(resp), nil
+}
+
+func (s *capacityClaimService) GetCapacityClaim(_ context.Context, req *connect.Request[inferencev1alpha1.GetCapacityClaimRequest]) (*connect.Response[inferencev1alpha1.GetCapacityClaimResponse], error) {
+	resp := &inferencev1alpha1.GetCapacityClaimResponse{}
+	if !s.server.capacityClaims.get(req.Msg.GetId(), func(e *capacityClaimEntry) { resp.CapacityClaim = clone(e.CapacityClaim) }) {
+		return nil, notFound(capacityClaimResourceType, req.Msg.GetId())
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *capacityClaimService) CreateCapacityClaim(_ context.Context, req *connect.Request[inferencev1alpha1.CreateCapacityClaimRequest]) (*connect.Response[inferencev1alpha1.CreateCapacityClaimResponse], error) {
+	if req.Msg.GetName() == "" {
+		return nil, invalidArgument("name", "must not be empty")
+	}
+
+	now := timestamppb.New(s.server.now())
+	claim := &inferencev1alpha1.CapacityClaim{
+		Spec: newSpec(&inferencev1alpha1.CapacityClaimSpec{}, req.Msg),
+		Status: &inferencev1alpha1.CapacityClaimStatus{
+			Status:           inferencev1alpha1.Status_STATUS_CREATING,
+			CreatedAt:        now,
+			UpdatedAt:        now,
+			PendingInstances: req.Msg.GetResources().GetInstanceCount(),
+		},
+	}
+
+	err := s.server.capacityClaims.create(&capacityClaimEntry{claim}, func(existing *capacityClaimEntry) bool {
+		return existing.id() == claim.GetSpec().GetId() || existing.GetSpec().GetName() == claim.GetSpec().GetName()
+	})
+	if err != nil {
+		return nil, apiError(capacityClaimResourceType, claim.GetSpec().GetName(), err)
+	}
+	return connect.NewResponse(&inferencev1alpha1.CreateCapacityClaimResponse{CapacityClaim: clone(claim)}), nil
+}
+
+func (s *capacityClaimService) UpdateCapacityClaim(_ context.Context, req *connect.Request[inferencev1alpha1.UpdateCapacityClaimRequest]) (*connect.Response[inferencev1alpha1.UpdateCapacityClaimResponse], error) {
+	resp := &inferencev1alpha1.UpdateCapacityClaimResponse{}
+	found, err := s.server.capacityClaims.update(req.Msg.GetId(), func(e *capacityClaimEntry) error {
+		copyFields(e.GetSpec().ProtoReflect(), req.Msg.ProtoReflect(), "id")
+		e.Status.Status = inferencev1alpha1.Status_STATUS_UPDATING
+		e.Status.UpdatedAt = timestamppb.New(s.server.now())
+		resp.CapacityClaim = clone(e.CapacityClaim)
+		return nil
+	})
+	if !found {
+		return nil, notFound(capacityClaimResourceType, req.Msg.GetId())
+	}
+	if err != nil {
+		return nil, apiError(capacityClaimResourceType, req.Msg.GetId(), err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *capacityClaimService) DeleteCapacityClaim(_ context.Context, req *connect.Request[inferencev1alpha1.DeleteCapacityClaimRequest]) (*connect.Response[inferencev1alpha1.DeleteCapacityClaimResponse], error) {
+	resp := &inferencev1alpha1.DeleteCapacityClaimResponse{}
+	found := s.server.capacityClaims.delete(req.Msg.GetId(), func(e *capacityClaimEntry) {
+		e.Status.Status = inferencev1alpha1.Status_STATUS_DELETING
+		e.Status.UpdatedAt = timestamppb.New(s.server.now())
+		resp.CapacityClaim = clone(e.CapacityClaim)
+	})
+	if !found {
+		return nil, notFound(capacityClaimResourceType, req.Msg.GetId())
+	}
+	return connect.NewResponse(resp), nil
+}
+
+type gatewayEntry struct {
+	*inferencev1alpha1.Gateway
+}
+
+func (e *gatewayEntry) id() string {
+	return e.GetSpec().GetId()
+}
+
+func (e *gatewayEntry) settle(now time.Time) {
+	e.Status.Status = inferencev1alpha1.Status_STATUS_READY
+	e.Status.UpdatedAt = timestamppb.New(now)
+	e.Status.Endpoints = []string{fmt.Sprintf("%s.inference.fake.coreweave.com", e.id())}
+	e.Status.Endpoints = append(e.Status.Endpoints, e.GetSpec().GetEndpointConfiguration().GetAdditionalDns()...)
+}
+
+type gatewayService struct {
+	inferencev1alpha1connect.UnimplementedGatewayServiceHandler
+
+	server *Server
+}
+
+func (s *gatewayService) ListGateways(_ context.Context, _ *connect.Request[inferencev1alpha1.ListGatewaysRequest]) (*connect.Response[inferencev1alpha1.ListGatewaysResponse], error) {
+	resp := &inferencev1alpha1.ListGatewaysResponse{}
+	s.server.gateways.list(func(e *gatewayEntry) {
+		resp.Items = append(resp.Items, clone(e.Gateway))
+	})
+	return connect.NewResponse(resp), nil
+}
+
+func (s *gatewayService) GetGateway(_ context.Context, req *connect.Request[inferencev1alpha1.GetGatewayRequest]) (*connect.Response[inferencev1alpha1.GetGatewayResponse], error) {
+	resp := &inferencev1alpha1.GetGatewayResponse{}
+	if !s.server.gateways.get(req.Msg.GetId(), func(e *gatewayEntry) { resp.Gateway = clone(e.Gateway) }) {
+		return nil, notFound(gatewayResourceType, req.Msg.GetId())
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *gatewayService) CreateGateway(_ context.Context, req *connect.Request[inferencev1alpha1.CreateGatewayRequest]) (*connect.Response[inferencev1alpha1.CreateGatewayResponse], error) {
+	if req.Msg.GetName() == "" {
+		return nil, invalidArgument("name", "must not be empty")
+	}
+
+	now := timestamppb.New(s.server.now())
+	gateway := &inferencev1alpha1.Gateway{
+		Spec: newSpec(&inferencev1alpha1.GatewaySpec{}, req.Msg),
+		Status: &inferencev1alpha1.GatewayStatus{
+			Status:    inferencev1alpha1.Status_STATUS_CREATING,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+
+	err := s.server.gateways.create(&gatewayEntry{gateway}, func(existing *gatewayEntry) bool {
+		return existing.id() == gateway.GetSpec().GetId() || existing.GetSpec().GetName() == gateway.GetSpec().GetName()
+	})
+	if err != nil {
+		return nil, apiError(gatewayResourceType, gateway.GetSpec().GetName(), err)
+	}
+	return connect.NewResponse(&inferencev1alpha1.CreateGatewayResponse{Gateway: clone(gateway)}), nil
+}
+
+func (s *gatewayService) UpdateGateway(_ context.Context, req *connect.Request[inferencev1alpha1.UpdateGatewayRequest]) (*connect.Response[inferencev1alpha1.UpdateGatewayResponse], error) {
+	resp := &inferencev1alpha1.UpdateGatewayResponse{}
+	found, err := s.server.gateways.update(req.Msg.GetId(), func(e *gatewayEntry) error {
+		copyFields(e.GetSpec().ProtoReflect(), req.Msg.ProtoReflect(), "id")
+		e.Status.Status = inferencev1alpha1.Status_STATUS_UPDATING
+		e.Status.UpdatedAt = timestamppb.New(s.server.now())
+		resp.Gateway = clone(e.Gateway)
+		return nil
+	})
+	if !found {
+		return nil, notFound(gatewayResourceType, req.Msg.GetId())
+	}
+	if err != nil {
+		return nil, apiError(gatewayResourceType, req.Msg.GetId(), err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *gatewayService) DeleteGateway(_ context.Context, req *connect.Request[inferencev1alpha1.DeleteGatewayRequest]) (*connect.Response[inferencev1alpha1.DeleteGatewayResponse], error) {
+	resp := &inferencev1alpha1.DeleteGatewayResponse{}
+	found := s.server.gateways.delete(req.Msg.GetId(), func(e *gatewayEntry) {
+		e.Status.Status = inferencev1alpha1.Status_STATUS_DELETING
+		e.Status.UpdatedAt = timestamppb.New(s.server.now())
+		resp.Gateway = clone(e.Gateway)
+	})
+	if !found {
+		return nil, notFound(gatewayResourceType, req.Msg.GetId())
+	}
+	return connect.NewResponse(resp), nil
+}
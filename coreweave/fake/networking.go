@@ -0,0 +1,110 @@
+package fake
+
+import (
+	"context"
+	"time"
+
+	"buf.build/gen/go/coreweave/networking/connectrpc/go/coreweave/networking/v1beta1/networkingv1beta1connect"
+	networkingv1beta1 "buf.build/gen/go/coreweave/networking/protocolbuffers/go/coreweave/networking/v1beta1"
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const vpcResourceType = "vpc"
+
+type vpcEntry struct {
+	*networkingv1beta1.VPC
+}
+
+func (e *vpcEntry) id() string {
+	return e.GetId()
+}
+
+func (e *vpcEntry) settle(now time.Time) {
+	e.Status = networkingv1beta1.VPC_STATUS_READY
+	e.UpdatedAt = timestamppb.New(now)
+}
+
+type vpcService struct {
+	networkingv1beta1connect.UnimplementedVPCServiceHandler
+
+	server *Server
+}
+
+func (s *vpcService) ListVPCs(_ context.Context, _ *connect.Request[networkingv1beta1.ListVPCsRequest]) (*connect.Response[networkingv1beta1.ListVPCsResponse], error) {
+	resp := &networkingv1beta1.ListVPCsResponse{}
+	s.server.vpcs.list(func(e *vpcEntry) {
+		resp.Items = append(resp.Items, clone(e.VPC))
+	})
+	return connect.NewResponse(resp), nil
+}
+
+func (s *vpcService) GetVPC(_ context.Context, req *connect.Request[networkingv1beta1.GetVPCRequest]) (*connect.Response[networkingv1beta1.GetVPCResponse], error) {
+	resp := &networkingv1beta1.GetVPCResponse{}
+	if !s.server.vpcs.get(req.Msg.GetId(), func(e *vpcEntry) { resp.Vpc = clone(e.VPC) }) {
+		return nil, notFound(vpcResourceType, req.Msg.GetId())
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *vpcService) CreateVPC(_ context.Context, req *connect.Request[networkingv1beta1.CreateVPCRequest]) (*connect.Response[networkingv1beta1.CreateVPCResponse], error) {
+	msg := req.Msg
+	switch {
+	case msg.GetName() == "":
+		return nil, invalidArgument("name", "must not be empty")
+	case msg.GetZone() == "":
+		return nil, invalidArgument("zone", "must not be empty")
+	}
+
+	now := timestamppb.New(s.server.now())
+	vpc := &networkingv1beta1.VPC{
+		Id:        newID(),
+		Status:    networkingv1beta1.VPC_STATUS_CREATING,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	copyFields(vpc.ProtoReflect(), msg.ProtoReflect())
+
+	err := s.server.vpcs.create(&vpcEntry{vpc}, func(existing *vpcEntry) bool {
+		return existing.GetName() == vpc.GetName() && existing.GetZone() == vpc.GetZone()
+	})
+	if err != nil {
+		return nil, apiError(vpcResourceType, vpc.GetName(), err)
+	}
+	return connect.NewResponse(&networkingv1beta1.CreateVPCResponse{Vpc: clone(vpc)}), nil
+}
+
+func (s *vpcService) UpdateVPC(_ context.Context, req *connect.Request[networkingv1beta1.UpdateVPCRequest]) (*connect.Response[networkingv1beta1.UpdateVPCResponse], error) {
+	resp := &networkingv1beta1.UpdateVPCResponse{}
+	found, err := s.server.vpcs.update(req.Msg.GetId(), func(e *vpcEntry) error {
+		if paths := req.Msg.GetUpdateMask().GetPaths(); len(paths) > 0 {
+			applyMask(e.ProtoReflect(), req.Msg.ProtoReflect(), paths)
+		} else {
+			copyFields(e.ProtoReflect(), req.Msg.ProtoReflect(), "id", "update_mask")
+		}
+		e.Status = networkingv1beta1.VPC_STATUS_UPDATING
+		e.UpdatedAt = timestamppb.New(s.server.now())
+		resp.Vpc = clone(e.VPC)
+		return nil
+	})
+	if !found {
+		return nil, notFound(vpcResourceType, req.Msg.GetId())
+	}
+	if err != nil {
+		return nil, apiError(vpcResourceType, req.Msg.GetId(), err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *vpcService) DeleteVPC(_ context.Context, req *connect.Request[networkingv1beta1.DeleteVPCRequest]) (*connect.Response[networkingv1beta1.DeleteVPCResponse], error) {
+	resp := &networkingv1beta1.DeleteVPCResponse{}
+	found := s.server.vpcs.delete(req.Msg.GetId(), func(e *vpcEntry) {
+		e.Status = networkingv1beta1.VPC_STATUS_DELETING
+		e.UpdatedAt = timestamppb.New(s.server.now())
+		resp.Vpc = clone(e.VPC)
+	})
+	if !found {
+		return nil, notFound(vpcResourceType, req.Msg.GetId())
+	}
+	return connect.NewResponse(resp), nil
+}
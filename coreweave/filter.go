@@ -0,0 +1,107 @@
+package coreweave
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NameRegexAttribute returns the schema of the name_regex filter of a data source that lists items of the given kind,
+// e.g. "clusters".
+func NameRegexAttribute(kind string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: nameRegexDescription(kind),
+		Optional:            true,
+	}
+}
+
+// ListNameRegexAttribute is like NameRegexAttribute, for the configuration of a list resource.
+func ListNameRegexAttribute(kind string) listschema.StringAttribute {
+	return listschema.StringAttribute{
+		MarkdownDescription: nameRegexDescription(kind),
+		Optional:            true,
+	}
+}
+
+func nameRegexDescription(kind string) string {
+	return fmt.Sprintf("A regular expression, in [RE2 syntax](https://github.com/google/re2/wiki/Syntax), that the names of the %s must match.", kind)
+}
+
+// CompileNameRegex compiles the name_regex filter of a data source. It returns nil, which matches every name, if the
+// filter is not set, and adds an error if it is not a valid regular expression.
+func CompileNameRegex(nameRegex types.String, diagnostics *diag.Diagnostics) *regexp.Regexp {
+	if nameRegex.IsNull() || nameRegex.IsUnknown() {
+		return nil
+	}
+
+	re, err := regexp.Compile(nameRegex.ValueString())
+	if err != nil {
+		diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+		return nil
+	}
+	return re
+}
+
+// MatchesFilters reports whether an item with the given name, zone and status matches the name_regex, zone and status
+// filters of a data source, where a filter that is not set matches every item.
+func MatchesFilters(nameRegex *regexp.Regexp, zone, status types.String, itemName, itemZone, itemStatus string) bool {
+	switch {
+	case nameRegex != nil && !nameRegex.MatchString(itemName):
+		return false
+	case !zone.IsNull() && zone.ValueString() != itemZone:
+		return false
+	case !status.IsNull() && status.ValueString() != itemStatus:
+		return false
+	default:
+		return true
+	}
+}
+
+// FindByName returns the only item of items with the given name and, if zone is set, zone, for a data source that
+// looks up a resourceType, e.g. "cluster", by name rather than ID. zoneOf may be nil for resources without a zone. It
+// adds an error and returns false if there is no such item, or more than one.
+func FindByName[T any](diagnostics *diag.Diagnostics, resourceType, name string, zone types.String, items []T, nameOf, zoneOf func(T) string) (T, bool) {
+	var (
+		zero    T
+		matches []T
+		zones   []string
+	)
+	for _, item := range items {
+		if nameOf(item) != name || (zoneOf != nil && !zone.IsNull() && zoneOf(item) != zone.ValueString()) {
+			continue
+		}
+		matches = append(matches, item)
+		if zoneOf != nil {
+			zones = append(zones, fmt.Sprintf("'%s'", zoneOf(item)))
+		}
+	}
+
+	title := strings.ToUpper(resourceType[:1]) + resourceType[1:]
+	switch {
+	case len(matches) == 0 && zoneOf != nil && !zone.IsNull():
+		diagnostics.AddAttributeError(path.Root("name"), title+" Not Found",
+			fmt.Sprintf("No %s named '%s' exists in zone '%s'.", resourceType, name, zone.ValueString()))
+		return zero, false
+	case len(matches) == 0:
+		diagnostics.AddAttributeError(path.Root("name"), title+" Not Found",
+			fmt.Sprintf("No %s named '%s' exists.", resourceType, name))
+		return zero, false
+	case len(matches) > 1 && zoneOf != nil:
+		diagnostics.AddAttributeError(path.Root("name"), "Ambiguous "+title+" Name",
+			fmt.Sprintf("%d %ss named '%s' exist, in zones %s. Set zone to choose one of them, or look it up by id instead.",
+				len(matches), resourceType, name, strings.Join(zones, ", ")))
+		return zero, false
+	case len(matches) > 1:
+		diagnostics.AddAttributeError(path.Root("name"), "Ambiguous "+title+" Name",
+			fmt.Sprintf("%d %ss named '%s' exist. Look it up by id instead.", len(matches), resourceType, name))
+		return zero, false
+	default:
+		return matches[0], true
+	}
+}
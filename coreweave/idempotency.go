@@ -0,0 +1,71 @@
+package coreweave
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// IdempotencyKeyHeader carries a unique key on every mutating API call, which retries of the call reuse, so that an
+// API that supports it can recognize a retried request. The API does not guarantee that it does, so the key does not
+// make a mutating call safe to retry.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+type idempotencyKeyContextKey struct{}
+
+// WithIdempotencyKey returns a context whose mutating API calls carry key as their idempotency key, instead of a
+// randomly generated one. The same key must only be reused for a retry of the same request.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+func idempotencyKeyFrom(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKeyContextKey{}).(string)
+	return key
+}
+
+// isReadOnlyProcedure reports whether the procedure of spec, e.g. "/coreweave.cks.v1beta1.ClusterService/GetCluster",
+// has no side effects and can therefore always be retried.
+func isReadOnlyProcedure(spec connect.Spec) bool {
+	if spec.IdempotencyLevel == connect.IdempotencyNoSideEffects {
+		return true
+	}
+	method := spec.Procedure[strings.LastIndex(spec.Procedure, "/")+1:]
+	return strings.HasPrefix(method, "Get") || strings.HasPrefix(method, "List")
+}
+
+// IdempotencyInterceptor sets IdempotencyKeyHeader on every mutating call that does not carry one yet, and classifies
+// each call for RetryPolicy: read-only calls are safe to retry after the server may have acted on them, while mutating
+// calls are only retried when they certainly did not reach the server, whether or not they carry an idempotency key.
+func IdempotencyInterceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if !req.Spec().IsClient {
+				return next(ctx, req)
+			}
+			if isReadOnlyProcedure(req.Spec()) {
+				return next(withRetryClass(ctx, retryClassSafe), req)
+			}
+
+			if req.Header().Get(IdempotencyKeyHeader) == "" {
+				key := idempotencyKeyFrom(ctx)
+				if key == "" {
+					var err error
+					if key, err = uuid.GenerateUUID(); err != nil {
+						tflog.Warn(ctx, "failed to generate an idempotency key", map[string]any{
+							"procedure": req.Spec().Procedure,
+							"error":     err.Error(),
+						})
+					}
+				}
+				if key != "" {
+					req.Header().Set(IdempotencyKeyHeader, key)
+				}
+			}
+			return next(withRetryClass(ctx, retryClassUnsafe), req)
+		}
+	})
+}
@@ -0,0 +1,184 @@
+package inference
+
+import (
+	"context"
+	"fmt"
+
+	inferencev1 "buf.build/gen/go/coreweave/inference/protocolbuffers/go/coreweave/inference/v1alpha1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &CapacityClaimDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &CapacityClaimDataSource{}
+)
+
+func NewCapacityClaimDataSource() datasource.DataSource {
+	return &CapacityClaimDataSource{}
+}
+
+type CapacityClaimDataSource struct {
+	client *coreweave.InferenceClient
+}
+
+// CapacityClaimDataSourceModel describes a capacity claim read by a data source.
+type CapacityClaimDataSourceModel struct {
+	ID                 types.String                 `tfsdk:"id"`
+	Name               types.String                 `tfsdk:"name"`
+	OrganizationID     types.String                 `tfsdk:"organization_id"`
+	Status             types.String                 `tfsdk:"status"`
+	CreatedAt          types.String                 `tfsdk:"created_at"`
+	UpdatedAt          types.String                 `tfsdk:"updated_at"`
+	Conditions         types.List                   `tfsdk:"conditions"`
+	AllocatedInstances types.Int64                  `tfsdk:"allocated_instances"`
+	PendingInstances   types.Int64                  `tfsdk:"pending_instances"`
+	Resources          *CapacityClaimResourcesModel `tfsdk:"resources"`
+}
+
+// Set populates the model from a proto CapacityClaim.
+func (m *CapacityClaimDataSourceModel) Set(cc *inferencev1.CapacityClaim) (diagnostics diag.Diagnostics) {
+	spec := cc.GetSpec()
+	status := cc.GetStatus()
+
+	m.ID = types.StringValue(spec.GetId())
+	m.Name = types.StringValue(spec.GetName())
+	m.OrganizationID = types.StringValue(spec.GetOrganizationId())
+	m.Status = types.StringValue(status.GetStatus().String())
+	m.CreatedAt = timestampValue(status.GetCreatedAt())
+	m.UpdatedAt = timestampValue(status.GetUpdatedAt())
+	m.AllocatedInstances = types.Int64Value(int64(status.GetAllocatedInstances()))
+	m.PendingInstances = types.Int64Value(int64(status.GetPendingInstances()))
+
+	var diags diag.Diagnostics
+	m.Conditions, diags = conditionsListFromStatus(status.GetConditions())
+	diagnostics.Append(diags...)
+
+	m.Resources = nil
+	if res := spec.GetResources(); res != nil {
+		zones, diags := stringSetValue(res.GetZones())
+		diagnostics.Append(diags...)
+		m.Resources = &CapacityClaimResourcesModel{
+			InstanceType:  types.StringValue(res.GetInstanceId()),
+			InstanceCount: types.Int64Value(int64(res.GetInstanceCount())),
+			CapacityType:  types.StringValue(res.GetCapacityType().String()),
+			Zones:         zones,
+		}
+	}
+	return diagnostics
+}
+
+// capacityClaimDataSourceAttributes adds the attributes specific to capacity claims to attributes.
+func capacityClaimDataSourceAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["allocated_instances"] = schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The number of instances currently allocated.",
+	}
+	attributes["pending_instances"] = schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The number of instances pending allocation.",
+	}
+	attributes["resources"] = schema.SingleNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "Resource configuration of the capacity claim.",
+		Attributes: map[string]schema.Attribute{
+			"instance_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The instance type reserved (e.g. `gb200-4x`).",
+			},
+			"instance_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of instances reserved.",
+			},
+			"capacity_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The [capacity type](https://docs.coreweave.com/products/inference/scaling#capacity-claims) of the capacity claim.",
+			},
+			"zones": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The availability zones the capacity claim may use resources from.",
+			},
+		},
+	}
+	return attributes
+}
+
+func (d *CapacityClaimDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inference_capacity_claim"
+}
+
+func (d *CapacityClaimDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Query information about an existing [CoreWeave Managed Inference](https://docs.coreweave.com/products/inference) [capacity claim](https://docs.coreweave.com/products/inference/scaling#capacity-claims) by ID or name.",
+		Attributes:          capacityClaimDataSourceAttributes(singleInferenceDataSourceAttributes("capacity claim")),
+	}
+}
+
+func (d *CapacityClaimDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+func (d *CapacityClaimDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*coreweave.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coreweave.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client.Inference
+}
+
+func (d *CapacityClaimDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CapacityClaimDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var claim *inferencev1.CapacityClaim
+	if !data.ID.IsNull() {
+		getResp, err := d.client.GetCapacityClaim(ctx, connect.NewRequest(&inferencev1.GetCapacityClaimRequest{
+			Id: data.ID.ValueString(),
+		}))
+		if err != nil {
+			coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+			return
+		}
+		claim = getResp.Msg.GetCapacityClaim()
+	} else {
+		listResp, err := d.client.ListCapacityClaims(ctx, connect.NewRequest(&inferencev1.ListCapacityClaimsRequest{}))
+		if err != nil {
+			coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+			return
+		}
+
+		var ok bool
+		claim, ok = coreweave.FindByName(&resp.Diagnostics, "capacity claim", data.Name.ValueString(), types.StringNull(), listResp.Msg.GetCapacityClaims(),
+			func(cc *inferencev1.CapacityClaim) string { return cc.GetSpec().GetName() }, nil)
+		if !ok {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(data.Set(claim)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
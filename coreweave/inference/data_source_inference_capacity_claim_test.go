@@ -0,0 +1,104 @@
+package inference_test
+
+import (
+	"testing"
+
+	inferencev1 "buf.build/gen/go/coreweave/inference/protocolbuffers/go/coreweave/inference/v1alpha1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave/inference"
+	"github.com/coreweave/terraform-provider-coreweave/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapacityClaimDataSource_Read(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.NewFakeClient(t)
+	created, err := client.Inference.CreateCapacityClaim(t.Context(), connect.NewRequest(&inferencev1.CreateCapacityClaimRequest{
+		Name: "reserved",
+		Resources: &inferencev1.CapacityClaimResources{
+			InstanceId:    "gb200-4x",
+			InstanceCount: 3,
+			CapacityType:  inferencev1.CapacityType_CAPACITY_TYPE_CUSTOMER,
+			Zones:         []string{"US-EAST-04A"},
+		},
+	}))
+	require.NoError(t, err)
+	id := created.Msg.GetCapacityClaim().GetSpec().GetId()
+
+	tests := []struct {
+		name        string
+		config      map[string]any
+		wantSummary string
+	}{
+		{name: "by id", config: map[string]any{"id": id}},
+		{name: "by name", config: map[string]any{"name": "reserved"}},
+		{name: "missing name", config: map[string]any{"name": "missing"}, wantSummary: "Capacity claim Not Found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state, diagnostics := testutil.ReadDataSource(t, inference.NewCapacityClaimDataSource(), client, tt.config)
+			if tt.wantSummary != "" {
+				require.True(t, diagnostics.HasError())
+				assert.Equal(t, tt.wantSummary, diagnostics[0].Summary())
+				return
+			}
+			require.False(t, diagnostics.HasError(), diagnostics)
+
+			var data inference.CapacityClaimDataSourceModel
+			require.False(t, state.Get(t.Context(), &data).HasError())
+			assert.Equal(t, id, data.ID.ValueString())
+			assert.Equal(t, inferencev1.Status_STATUS_READY.String(), data.Status.ValueString())
+			assert.Equal(t, int64(3), data.AllocatedInstances.ValueInt64())
+			assert.Equal(t, int64(0), data.PendingInstances.ValueInt64())
+			require.NotNil(t, data.Resources)
+			assert.Equal(t, "gb200-4x", data.Resources.InstanceType.ValueString())
+			assert.Equal(t, inferencev1.CapacityType_CAPACITY_TYPE_CUSTOMER.String(), data.Resources.CapacityType.ValueString())
+		})
+	}
+}
+
+func TestCapacityClaimsDataSource_Read(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.NewFakeClient(t)
+	for _, name := range []string{"us-east", "eu-west", "us-west"} {
+		_, err := client.Inference.CreateCapacityClaim(t.Context(), connect.NewRequest(&inferencev1.CreateCapacityClaimRequest{
+			Name:      name,
+			Resources: &inferencev1.CapacityClaimResources{InstanceId: "gb200-4x", InstanceCount: 1},
+		}))
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name   string
+		config map[string]any
+		want   []string
+	}{
+		{name: "all capacity claims, sorted by name", want: []string{"eu-west", "us-east", "us-west"}},
+		{name: "by name regex", config: map[string]any{"name_regex": "^us-"}, want: []string{"us-east", "us-west"}},
+		{name: "by status", config: map[string]any{"status": inferencev1.Status_STATUS_FAILED.String()}, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state, diagnostics := testutil.ReadDataSource(t, inference.NewCapacityClaimsDataSource(), client, tt.config)
+			require.False(t, diagnostics.HasError(), diagnostics)
+
+			var data inference.CapacityClaimsDataSourceModel
+			require.False(t, state.Get(t.Context(), &data).HasError())
+
+			names := make([]string, len(data.CapacityClaims))
+			for i, claim := range data.CapacityClaims {
+				names[i] = claim.Name.ValueString()
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package inference
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	inferencev1 "buf.build/gen/go/coreweave/inference/protocolbuffers/go/coreweave/inference/v1alpha1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CapacityClaimsDataSource{}
+
+func NewCapacityClaimsDataSource() datasource.DataSource {
+	return &CapacityClaimsDataSource{}
+}
+
+type CapacityClaimsDataSource struct {
+	client *coreweave.InferenceClient
+}
+
+// CapacityClaimsDataSourceModel describes the data source data model.
+type CapacityClaimsDataSourceModel struct {
+	NameRegex      types.String                   `tfsdk:"name_regex"`
+	Status         types.String                   `tfsdk:"status"`
+	CapacityClaims []CapacityClaimDataSourceModel `tfsdk:"capacity_claims"`
+}
+
+func (d *CapacityClaimsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inference_capacity_claims"
+}
+
+func (d *CapacityClaimsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the existing [CoreWeave Managed Inference](https://docs.coreweave.com/products/inference) [capacity claims](https://docs.coreweave.com/products/inference/scaling#capacity-claims), optionally filtered by name and status.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": coreweave.NameRegexAttribute("capacity claims"),
+			"status":     statusFilterAttribute("capacity claims"),
+			"capacity_claims": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The capacity claims that match the filters, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: capacityClaimDataSourceAttributes(listedInferenceDataSourceAttributes("capacity claim")),
+				},
+			},
+		},
+	}
+}
+
+func (d *CapacityClaimsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*coreweave.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coreweave.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client.Inference
+}
+
+func (d *CapacityClaimsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CapacityClaimsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegex := coreweave.CompileNameRegex(data.NameRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ListCapacityClaims is not paginated: a single response holds every capacity claim of the organization.
+	listResp, err := d.client.ListCapacityClaims(ctx, connect.NewRequest(&inferencev1.ListCapacityClaimsRequest{}))
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+		return
+	}
+
+	claims := slices.DeleteFunc(slices.Clone(listResp.Msg.GetCapacityClaims()), func(cc *inferencev1.CapacityClaim) bool {
+		return !coreweave.MatchesFilters(nameRegex, types.StringNull(), data.Status, cc.GetSpec().GetName(), "", cc.GetStatus().GetStatus().String())
+	})
+	slices.SortFunc(claims, func(a, b *inferencev1.CapacityClaim) int {
+		return cmp.Or(cmp.Compare(a.GetSpec().GetName(), b.GetSpec().GetName()), cmp.Compare(a.GetSpec().GetId(), b.GetSpec().GetId()))
+	})
+
+	data.CapacityClaims = make([]CapacityClaimDataSourceModel, len(claims))
+	for i, claim := range claims {
+		resp.Diagnostics.Append(data.CapacityClaims[i].Set(claim)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
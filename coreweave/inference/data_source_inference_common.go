@@ -0,0 +1,129 @@
+package inference
+
+import (
+	"fmt"
+	"time"
+
+	inferencev1 "buf.build/gen/go/coreweave/inference/protocolbuffers/go/coreweave/inference/v1alpha1"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// inferenceDataSourceAttributes returns the attributes that the data sources of every kind of inference object, e.g.
+// "gateway", have in common, other than id and name, which are looked up by the data source of a single object but
+// computed for the items of the data source that lists them.
+func inferenceDataSourceAttributes(kind string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"organization_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("The organization ID that owns the %s.", kind),
+		},
+		"status": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("The current status of the %s. See the [Inference API overview](https://docs.coreweave.com/products/inference/reference/api-overview#status-values) for status values.", kind),
+		},
+		"created_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("RFC3339 timestamp of when the %s was created.", kind),
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("RFC3339 timestamp of when the %s was last updated.", kind),
+		},
+		"conditions": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("Detailed status conditions for the %s.", kind),
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The condition type (e.g. `Ready`, `Progressing`).",
+					},
+					"status": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The condition status (`True`, `False`, or `Unknown`).",
+					},
+					"last_update_time": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "RFC3339 timestamp of the last condition transition.",
+					},
+					"reason": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "A short, machine-readable reason for the condition's last transition.",
+					},
+					"message": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "A human-readable message about the condition's last transition.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// singleInferenceDataSourceAttributes returns the attributes of the data source of a single inference object of the
+// given kind, which is looked up by id or name.
+func singleInferenceDataSourceAttributes(kind string) map[string]schema.Attribute {
+	attributes := inferenceDataSourceAttributes(kind)
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: fmt.Sprintf("The unique identifier of the %s. Exactly one of `id` or `name` must be set.", kind),
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: fmt.Sprintf("The name of the %[1]s. Exactly one of `id` or `name` must be set. Looking a %[1]s up by name fails if there is no %[1]s with the name, or more than one.", kind),
+	}
+	return attributes
+}
+
+// listedInferenceDataSourceAttributes returns the attributes of an inference object of the given kind listed by a data
+// source.
+func listedInferenceDataSourceAttributes(kind string) map[string]schema.Attribute {
+	attributes := inferenceDataSourceAttributes(kind)
+	attributes["id"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: fmt.Sprintf("The unique identifier of the %s.", kind),
+	}
+	attributes["name"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: fmt.Sprintf("The name of the %s.", kind),
+	}
+	return attributes
+}
+
+// statusFilterAttribute returns the schema of the status filter of a data source that lists inference objects of the
+// given kind, e.g. "gateways".
+func statusFilterAttribute(kind string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: fmt.Sprintf("Only list the %s with this status. Must be one of %s.", kind, coreweave.EnumMarkdownValues(inferencev1.Status_name, true)),
+		Validators: []validator.String{
+			stringvalidator.OneOf(coreweave.EnumValues(inferencev1.Status_name, true)...),
+		},
+	}
+}
+
+// timestampValue formats ts as RFC3339, or returns null if it is not set.
+func timestampValue(ts *timestamppb.Timestamp) types.String {
+	if ts == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(ts.AsTime().Format(time.RFC3339))
+}
+
+// stringSetValue converts values into a set of strings.
+func stringSetValue(values []string) (types.Set, diag.Diagnostics) {
+	elements := make([]attr.Value, len(values))
+	for i, v := range values {
+		elements[i] = types.StringValue(v)
+	}
+	return types.SetValue(types.StringType, elements)
+}
@@ -0,0 +1,201 @@
+package inference
+
+import (
+	"context"
+	"fmt"
+
+	inferencev1 "buf.build/gen/go/coreweave/inference/protocolbuffers/go/coreweave/inference/v1alpha1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &DeploymentDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &DeploymentDataSource{}
+)
+
+func NewDeploymentDataSource() datasource.DataSource {
+	return &DeploymentDataSource{}
+}
+
+type DeploymentDataSource struct {
+	client *coreweave.InferenceClient
+}
+
+// DeploymentDataSourceModel describes a deployment read by a data source.
+type DeploymentDataSourceModel struct {
+	ID             types.String           `tfsdk:"id"`
+	Name           types.String           `tfsdk:"name"`
+	OrganizationID types.String           `tfsdk:"organization_id"`
+	Status         types.String           `tfsdk:"status"`
+	CreatedAt      types.String           `tfsdk:"created_at"`
+	UpdatedAt      types.String           `tfsdk:"updated_at"`
+	Conditions     types.List             `tfsdk:"conditions"`
+	GatewayIds     types.Set              `tfsdk:"gateway_ids"`
+	Disabled       types.Bool             `tfsdk:"disabled"`
+	Resources      *ResourcesModel        `tfsdk:"resources"`
+	Model          *DeploymentModelConfig `tfsdk:"model"`
+}
+
+// Set populates the model from a proto Deployment.
+func (m *DeploymentDataSourceModel) Set(d *inferencev1.Deployment) (diagnostics diag.Diagnostics) {
+	spec := d.GetSpec()
+	status := d.GetStatus()
+
+	m.ID = types.StringValue(spec.GetId())
+	m.Name = types.StringValue(spec.GetName())
+	m.OrganizationID = types.StringValue(spec.GetOrganizationId())
+	m.Status = types.StringValue(status.GetStatus().String())
+	m.CreatedAt = timestampValue(status.GetCreatedAt())
+	m.UpdatedAt = timestampValue(status.GetUpdatedAt())
+	m.Disabled = types.BoolValue(spec.GetDisabled())
+
+	var diags diag.Diagnostics
+	m.Conditions, diags = conditionsListFromStatus(status.GetConditions())
+	diagnostics.Append(diags...)
+	m.GatewayIds, diags = stringSetValue(spec.GetGatewayIds())
+	diagnostics.Append(diags...)
+
+	m.Resources = nil
+	if res := spec.GetResources(); res != nil {
+		m.Resources = &ResourcesModel{
+			InstanceType: types.StringValue(res.GetInstanceType()),
+			GpuCount:     types.Int64Value(int64(res.GetGpuCount())),
+		}
+	}
+
+	m.Model = nil
+	if mod := spec.GetModel(); mod != nil {
+		m.Model = &DeploymentModelConfig{
+			Name:   types.StringValue(mod.GetName()),
+			Bucket: types.StringValue(mod.GetBucket()),
+			Path:   types.StringValue(mod.GetPath()),
+		}
+	}
+	return diagnostics
+}
+
+// deploymentDataSourceAttributes adds the attributes specific to deployments to attributes.
+func deploymentDataSourceAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["gateway_ids"] = schema.SetAttribute{
+		Computed:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "The IDs of the [gateways](https://docs.coreweave.com/products/inference/gateways) the deployment is associated with.",
+	}
+	attributes["disabled"] = schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Whether the deployment is disabled.",
+	}
+	attributes["resources"] = schema.SingleNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "[GPU resource](https://docs.coreweave.com/products/inference/models) configuration of the deployment.",
+		Attributes: map[string]schema.Attribute{
+			"instance_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The instance type the deployment runs on.",
+			},
+			"gpu_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of GPUs per instance.",
+			},
+		},
+	}
+	attributes["model"] = schema.SingleNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "[Model](https://docs.coreweave.com/products/inference/models) configuration of the deployment.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The model name used in API requests (e.g. the `/models` endpoint).",
+			},
+			"bucket": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The CAIOS bucket the model is stored in.",
+			},
+			"path": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The CAIOS path to the model and its configuration files.",
+			},
+		},
+	}
+	return attributes
+}
+
+func (d *DeploymentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inference_deployment"
+}
+
+func (d *DeploymentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Query information about an existing [CoreWeave Managed Inference](https://docs.coreweave.com/products/inference) deployment by ID or name.",
+		Attributes:          deploymentDataSourceAttributes(singleInferenceDataSourceAttributes("deployment")),
+	}
+}
+
+func (d *DeploymentDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+func (d *DeploymentDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*coreweave.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coreweave.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client.Inference
+}
+
+func (d *DeploymentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DeploymentDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var deployment *inferencev1.Deployment
+	if !data.ID.IsNull() {
+		getResp, err := d.client.GetDeployment(ctx, connect.NewRequest(&inferencev1.GetDeploymentRequest{
+			Id: data.ID.ValueString(),
+		}))
+		if err != nil {
+			coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+			return
+		}
+		deployment = getResp.Msg.GetDeployment()
+	} else {
+		listResp, err := d.client.ListDeployments(ctx, connect.NewRequest(&inferencev1.ListDeploymentsRequest{}))
+		if err != nil {
+			coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+			return
+		}
+
+		var ok bool
+		deployment, ok = coreweave.FindByName(&resp.Diagnostics, "deployment", data.Name.ValueString(), types.StringNull(), listResp.Msg.GetItems(),
+			func(d *inferencev1.Deployment) string { return d.GetSpec().GetName() }, nil)
+		if !ok {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(data.Set(deployment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,124 @@
+package inference_test
+
+import (
+	"testing"
+
+	inferencev1 "buf.build/gen/go/coreweave/inference/protocolbuffers/go/coreweave/inference/v1alpha1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave/inference"
+	"github.com/coreweave/terraform-provider-coreweave/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentDataSource_Read(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.NewFakeClient(t)
+	gateway, err := client.Inference.CreateGateway(t.Context(), connect.NewRequest(&inferencev1.CreateGatewayRequest{Name: "public"}))
+	require.NoError(t, err)
+	gatewayID := gateway.Msg.GetGateway().GetSpec().GetId()
+
+	created, err := client.Inference.CreateDeployment(t.Context(), connect.NewRequest(&inferencev1.CreateDeploymentRequest{
+		Name:       "llama",
+		GatewayIds: []string{gatewayID},
+		Resources:  &inferencev1.DeploymentResources{InstanceType: "gd-8xh100ib-i128", GpuCount: 8},
+		Model:      &inferencev1.DeploymentModel{Name: "llama", Bucket: "models", Path: "llama/"},
+	}))
+	require.NoError(t, err)
+	id := created.Msg.GetDeployment().GetSpec().GetId()
+
+	tests := []struct {
+		name        string
+		config      map[string]any
+		wantSummary string
+	}{
+		{name: "by id", config: map[string]any{"id": id}},
+		{name: "by name", config: map[string]any{"name": "llama"}},
+		{name: "missing name", config: map[string]any{"name": "missing"}, wantSummary: "Deployment Not Found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state, diagnostics := testutil.ReadDataSource(t, inference.NewDeploymentDataSource(), client, tt.config)
+			if tt.wantSummary != "" {
+				require.True(t, diagnostics.HasError())
+				assert.Equal(t, tt.wantSummary, diagnostics[0].Summary())
+				return
+			}
+			require.False(t, diagnostics.HasError(), diagnostics)
+
+			var data inference.DeploymentDataSourceModel
+			require.False(t, state.Get(t.Context(), &data).HasError())
+			assert.Equal(t, id, data.ID.ValueString())
+			assert.Equal(t, "llama", data.Name.ValueString())
+			assert.Equal(t, inferencev1.Status_STATUS_READY.String(), data.Status.ValueString())
+			require.NotNil(t, data.Resources)
+			assert.Equal(t, int64(8), data.Resources.GpuCount.ValueInt64())
+			require.NotNil(t, data.Model)
+			assert.Equal(t, "models", data.Model.Bucket.ValueString())
+
+			var gatewayIDs []string
+			require.False(t, data.GatewayIds.ElementsAs(t.Context(), &gatewayIDs, false).HasError())
+			assert.Equal(t, []string{gatewayID}, gatewayIDs)
+		})
+	}
+}
+
+func TestDeploymentsDataSource_Read(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.NewFakeClient(t)
+	gatewayIDs := map[string]string{}
+	for _, name := range []string{"public", "internal"} {
+		gateway, err := client.Inference.CreateGateway(t.Context(), connect.NewRequest(&inferencev1.CreateGatewayRequest{Name: name}))
+		require.NoError(t, err)
+		gatewayIDs[name] = gateway.Msg.GetGateway().GetSpec().GetId()
+	}
+	for _, deployment := range []struct{ name, gateway string }{
+		{"llama-b", "public"},
+		{"qwen", "internal"},
+		{"llama-a", "internal"},
+	} {
+		_, err := client.Inference.CreateDeployment(t.Context(), connect.NewRequest(&inferencev1.CreateDeploymentRequest{
+			Name:       deployment.name,
+			GatewayIds: []string{gatewayIDs[deployment.gateway]},
+		}))
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name   string
+		config map[string]any
+		want   []string
+	}{
+		{name: "all deployments, sorted by name", want: []string{"llama-a", "llama-b", "qwen"}},
+		{name: "by gateway", config: map[string]any{"gateway_id": gatewayIDs["internal"]}, want: []string{"llama-a", "qwen"}},
+		{
+			name:   "by name regex and status",
+			config: map[string]any{"name_regex": "^llama-", "status": inferencev1.Status_STATUS_READY.String()},
+			want:   []string{"llama-a", "llama-b"},
+		},
+		{name: "no match", config: map[string]any{"status": inferencev1.Status_STATUS_FAILED.String()}, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state, diagnostics := testutil.ReadDataSource(t, inference.NewDeploymentsDataSource(), client, tt.config)
+			require.False(t, diagnostics.HasError(), diagnostics)
+
+			var data inference.DeploymentsDataSourceModel
+			require.False(t, state.Get(t.Context(), &data).HasError())
+
+			names := make([]string, len(data.Deployments))
+			for i, deployment := range data.Deployments {
+				names[i] = deployment.Name.ValueString()
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
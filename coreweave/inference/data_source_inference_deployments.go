@@ -0,0 +1,113 @@
+package inference
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	inferencev1 "buf.build/gen/go/coreweave/inference/protocolbuffers/go/coreweave/inference/v1alpha1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DeploymentsDataSource{}
+
+func NewDeploymentsDataSource() datasource.DataSource {
+	return &DeploymentsDataSource{}
+}
+
+type DeploymentsDataSource struct {
+	client *coreweave.InferenceClient
+}
+
+// DeploymentsDataSourceModel describes the data source data model.
+type DeploymentsDataSourceModel struct {
+	GatewayID   types.String                `tfsdk:"gateway_id"`
+	NameRegex   types.String                `tfsdk:"name_regex"`
+	Status      types.String                `tfsdk:"status"`
+	Deployments []DeploymentDataSourceModel `tfsdk:"deployments"`
+}
+
+func (d *DeploymentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inference_deployments"
+}
+
+func (d *DeploymentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the existing [CoreWeave Managed Inference](https://docs.coreweave.com/products/inference) deployments, optionally filtered by gateway, name and status.",
+		Attributes: map[string]schema.Attribute{
+			"gateway_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the deployments associated with the [gateway](https://docs.coreweave.com/products/inference/gateways) with this ID.",
+			},
+			"name_regex": coreweave.NameRegexAttribute("deployments"),
+			"status":     statusFilterAttribute("deployments"),
+			"deployments": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The deployments that match the filters, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: deploymentDataSourceAttributes(listedInferenceDataSourceAttributes("deployment")),
+				},
+			},
+		},
+	}
+}
+
+func (d *DeploymentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*coreweave.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coreweave.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client.Inference
+}
+
+func (d *DeploymentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DeploymentsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegex := coreweave.CompileNameRegex(data.NameRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ListDeployments is not paginated: a single response holds every deployment of the organization, or of the gateway.
+	listResp, err := d.client.ListDeployments(ctx, connect.NewRequest(&inferencev1.ListDeploymentsRequest{
+		ParentGatewayId: data.GatewayID.ValueString(),
+	}))
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+		return
+	}
+
+	deployments := slices.DeleteFunc(slices.Clone(listResp.Msg.GetItems()), func(d *inferencev1.Deployment) bool {
+		return !coreweave.MatchesFilters(nameRegex, types.StringNull(), data.Status, d.GetSpec().GetName(), "", d.GetStatus().GetStatus().String())
+	})
+	slices.SortFunc(deployments, func(a, b *inferencev1.Deployment) int {
+		return cmp.Or(cmp.Compare(a.GetSpec().GetName(), b.GetSpec().GetName()), cmp.Compare(a.GetSpec().GetId(), b.GetSpec().GetId()))
+	})
+
+	data.Deployments = make([]DeploymentDataSourceModel, len(deployments))
+	for i, deployment := range deployments {
+		resp.Diagnostics.Append(data.Deployments[i].Set(deployment)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
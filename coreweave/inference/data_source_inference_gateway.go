@@ -0,0 +1,152 @@
+package inference
+
+import (
+	"context"
+	"fmt"
+
+	inferencev1 "buf.build/gen/go/coreweave/inference/protocolbuffers/go/coreweave/inference/v1alpha1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &GatewayDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &GatewayDataSource{}
+)
+
+func NewGatewayDataSource() datasource.DataSource {
+	return &GatewayDataSource{}
+}
+
+type GatewayDataSource struct {
+	client *coreweave.InferenceClient
+}
+
+// GatewayDataSourceModel describes a gateway read by a data source.
+type GatewayDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	Status         types.String `tfsdk:"status"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+	Conditions     types.List   `tfsdk:"conditions"`
+	Zones          types.Set    `tfsdk:"zones"`
+	Endpoints      types.Set    `tfsdk:"endpoints"`
+}
+
+// Set populates the model from a proto Gateway.
+func (m *GatewayDataSourceModel) Set(gw *inferencev1.Gateway) (diagnostics diag.Diagnostics) {
+	spec := gw.GetSpec()
+	status := gw.GetStatus()
+
+	m.ID = types.StringValue(spec.GetId())
+	m.Name = types.StringValue(spec.GetName())
+	m.OrganizationID = types.StringValue(spec.GetOrganizationId())
+	m.Status = types.StringValue(status.GetStatus().String())
+	m.CreatedAt = timestampValue(status.GetCreatedAt())
+	m.UpdatedAt = timestampValue(status.GetUpdatedAt())
+
+	var diags diag.Diagnostics
+	m.Conditions, diags = conditionsListFromStatus(status.GetConditions())
+	diagnostics.Append(diags...)
+	m.Zones, diags = stringSetValue(spec.GetZones())
+	diagnostics.Append(diags...)
+	m.Endpoints, diags = stringSetValue(status.GetEndpoints())
+	diagnostics.Append(diags...)
+	return diagnostics
+}
+
+// gatewayDataSourceAttributes adds the attributes specific to gateways to attributes.
+func gatewayDataSourceAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["zones"] = schema.SetAttribute{
+		Computed:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "The zones the gateway is available in.",
+	}
+	attributes["endpoints"] = schema.SetAttribute{
+		Computed:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "The endpoint URIs for the gateway.",
+	}
+	return attributes
+}
+
+func (d *GatewayDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inference_gateway"
+}
+
+func (d *GatewayDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Query information about an existing [CoreWeave Managed Inference](https://docs.coreweave.com/products/inference) [gateway](https://docs.coreweave.com/products/inference/gateways) by ID or name, e.g. to attach deployments to a gateway managed elsewhere.",
+		Attributes:          gatewayDataSourceAttributes(singleInferenceDataSourceAttributes("gateway")),
+	}
+}
+
+func (d *GatewayDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+func (d *GatewayDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*coreweave.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coreweave.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client.Inference
+}
+
+func (d *GatewayDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GatewayDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var gw *inferencev1.Gateway
+	if !data.ID.IsNull() {
+		getResp, err := d.client.GetGateway(ctx, connect.NewRequest(&inferencev1.GetGatewayRequest{
+			Id: data.ID.ValueString(),
+		}))
+		if err != nil {
+			coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+			return
+		}
+		gw = getResp.Msg.GetGateway()
+	} else {
+		listResp, err := d.client.ListGateways(ctx, connect.NewRequest(&inferencev1.ListGatewaysRequest{}))
+		if err != nil {
+			coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+			return
+		}
+
+		var ok bool
+		gw, ok = coreweave.FindByName(&resp.Diagnostics, "gateway", data.Name.ValueString(), types.StringNull(), listResp.Msg.GetItems(),
+			func(gw *inferencev1.Gateway) string { return gw.GetSpec().GetName() }, nil)
+		if !ok {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(data.Set(gw)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,101 @@
+package inference_test
+
+import (
+	"testing"
+
+	inferencev1 "buf.build/gen/go/coreweave/inference/protocolbuffers/go/coreweave/inference/v1alpha1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave/inference"
+	"github.com/coreweave/terraform-provider-coreweave/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGatewayDataSource_Read(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.NewFakeClient(t)
+	ids := map[string]string{}
+	for _, name := range []string{"public", "internal"} {
+		created, err := client.Inference.CreateGateway(t.Context(), connect.NewRequest(&inferencev1.CreateGatewayRequest{
+			Name:  name,
+			Zones: []string{"US-EAST-04A"},
+		}))
+		require.NoError(t, err)
+		ids[name] = created.Msg.GetGateway().GetSpec().GetId()
+	}
+
+	tests := []struct {
+		name        string
+		config      map[string]any
+		want        string
+		wantSummary string
+	}{
+		{name: "by id", config: map[string]any{"id": ids["internal"]}, want: "internal"},
+		{name: "by name", config: map[string]any{"name": "public"}, want: "public"},
+		{name: "missing name", config: map[string]any{"name": "missing"}, wantSummary: "Gateway Not Found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state, diagnostics := testutil.ReadDataSource(t, inference.NewGatewayDataSource(), client, tt.config)
+			if tt.wantSummary != "" {
+				require.True(t, diagnostics.HasError())
+				assert.Equal(t, tt.wantSummary, diagnostics[0].Summary())
+				return
+			}
+			require.False(t, diagnostics.HasError(), diagnostics)
+
+			var data inference.GatewayDataSourceModel
+			require.False(t, state.Get(t.Context(), &data).HasError())
+			assert.Equal(t, tt.want, data.Name.ValueString())
+			assert.Equal(t, ids[tt.want], data.ID.ValueString())
+			assert.Equal(t, inferencev1.Status_STATUS_READY.String(), data.Status.ValueString())
+
+			var endpoints []string
+			require.False(t, data.Endpoints.ElementsAs(t.Context(), &endpoints, false).HasError())
+			assert.Equal(t, []string{ids[tt.want] + ".inference.fake.coreweave.com"}, endpoints)
+		})
+	}
+}
+
+func TestGatewaysDataSource_Read(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.NewFakeClient(t)
+	for _, name := range []string{"prod-b", "dev", "prod-a"} {
+		_, err := client.Inference.CreateGateway(t.Context(), connect.NewRequest(&inferencev1.CreateGatewayRequest{Name: name}))
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name   string
+		config map[string]any
+		want   []string
+	}{
+		{name: "all gateways, sorted by name", want: []string{"dev", "prod-a", "prod-b"}},
+		{name: "by name regex", config: map[string]any{"name_regex": "^prod-"}, want: []string{"prod-a", "prod-b"}},
+		{name: "by status", config: map[string]any{"status": inferencev1.Status_STATUS_FAILED.String()}, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state, diagnostics := testutil.ReadDataSource(t, inference.NewGatewaysDataSource(), client, tt.config)
+			require.False(t, diagnostics.HasError(), diagnostics)
+
+			var data inference.GatewaysDataSourceModel
+			require.False(t, state.Get(t.Context(), &data).HasError())
+
+			names := make([]string, len(data.Gateways))
+			for i, gateway := range data.Gateways {
+				names[i] = gateway.Name.ValueString()
+				assert.NotEmpty(t, gateway.ID.ValueString())
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
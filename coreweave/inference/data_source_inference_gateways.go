@@ -0,0 +1,106 @@
+package inference
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	inferencev1 "buf.build/gen/go/coreweave/inference/protocolbuffers/go/coreweave/inference/v1alpha1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &GatewaysDataSource{}
+
+func NewGatewaysDataSource() datasource.DataSource {
+	return &GatewaysDataSource{}
+}
+
+type GatewaysDataSource struct {
+	client *coreweave.InferenceClient
+}
+
+// GatewaysDataSourceModel describes the data source data model.
+type GatewaysDataSourceModel struct {
+	NameRegex types.String             `tfsdk:"name_regex"`
+	Status    types.String             `tfsdk:"status"`
+	Gateways  []GatewayDataSourceModel `tfsdk:"gateways"`
+}
+
+func (d *GatewaysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inference_gateways"
+}
+
+func (d *GatewaysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the existing [CoreWeave Managed Inference](https://docs.coreweave.com/products/inference) [gateways](https://docs.coreweave.com/products/inference/gateways), optionally filtered by name and status.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": coreweave.NameRegexAttribute("gateways"),
+			"status":     statusFilterAttribute("gateways"),
+			"gateways": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The gateways that match the filters, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: gatewayDataSourceAttributes(listedInferenceDataSourceAttributes("gateway")),
+				},
+			},
+		},
+	}
+}
+
+func (d *GatewaysDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*coreweave.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coreweave.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client.Inference
+}
+
+func (d *GatewaysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GatewaysDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegex := coreweave.CompileNameRegex(data.NameRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ListGateways is not paginated: a single response holds every gateway of the organization.
+	listResp, err := d.client.ListGateways(ctx, connect.NewRequest(&inferencev1.ListGatewaysRequest{}))
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+		return
+	}
+
+	gateways := slices.DeleteFunc(slices.Clone(listResp.Msg.GetItems()), func(gw *inferencev1.Gateway) bool {
+		return !coreweave.MatchesFilters(nameRegex, types.StringNull(), data.Status, gw.GetSpec().GetName(), "", gw.GetStatus().GetStatus().String())
+	})
+	slices.SortFunc(gateways, func(a, b *inferencev1.Gateway) int {
+		return cmp.Or(cmp.Compare(a.GetSpec().GetName(), b.GetSpec().GetName()), cmp.Compare(a.GetSpec().GetId(), b.GetSpec().GetId()))
+	})
+
+	data.Gateways = make([]GatewayDataSourceModel, len(gateways))
+	for i, gw := range gateways {
+		resp.Diagnostics.Append(data.Gateways[i].Set(gw)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
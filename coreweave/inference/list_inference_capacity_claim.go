@@ -0,0 +1,105 @@
+package inference
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	inferencev1 "buf.build/gen/go/coreweave/inference/protocolbuffers/go/coreweave/inference/v1alpha1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &CapacityClaimListResource{}
+	_ list.ListResourceWithConfigure = &CapacityClaimListResource{}
+)
+
+func NewCapacityClaimListResource() list.ListResource {
+	return &CapacityClaimListResource{}
+}
+
+// CapacityClaimListResource lists the existing capacity claims for `terraform query`, so that they can be imported.
+type CapacityClaimListResource struct {
+	client *coreweave.InferenceClient
+}
+
+type CapacityClaimListResourceModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+}
+
+func (l *CapacityClaimListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inference_capacity_claim"
+}
+
+func (l *CapacityClaimListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the existing [CoreWeave Managed Inference](https://docs.coreweave.com/products/inference) capacity claims, optionally filtered by name, to import them.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": coreweave.ListNameRegexAttribute("capacity claims"),
+		},
+	}
+}
+
+func (l *CapacityClaimListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*coreweave.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *coreweave.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	l.client = client.Inference
+}
+
+func (l *CapacityClaimListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data CapacityClaimListResourceModel
+	diagnostics := req.Config.Get(ctx, &data)
+	nameRegex := coreweave.CompileNameRegex(data.NameRegex, &diagnostics)
+	if diagnostics.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+
+	// ListCapacityClaims is not paginated: a single response holds every capacity claim of the organization.
+	listResp, err := l.client.ListCapacityClaims(ctx, connect.NewRequest(&inferencev1.ListCapacityClaimsRequest{}))
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, &diagnostics)
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+
+	claims := slices.DeleteFunc(slices.Clone(listResp.Msg.GetCapacityClaims()), func(cc *inferencev1.CapacityClaim) bool {
+		return !coreweave.MatchesFilters(nameRegex, types.StringNull(), types.StringNull(), cc.GetSpec().GetName(), "", "")
+	})
+	slices.SortFunc(claims, func(a, b *inferencev1.CapacityClaim) int {
+		return cmp.Or(cmp.Compare(a.GetSpec().GetName(), b.GetSpec().GetName()), cmp.Compare(a.GetSpec().GetId(), b.GetSpec().GetId()))
+	})
+
+	stream.Results = coreweave.ListResults(ctx, req, claims, func(claim *inferencev1.CapacityClaim, result *list.ListResult) {
+		result.DisplayName = claim.GetSpec().GetName()
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), claim.GetSpec().GetId())...)
+		if !req.IncludeResource {
+			return
+		}
+
+		state := InferenceCapacityClaimResourceModel{Timeouts: coreweave.NullTimeouts(ctx)}
+		result.Diagnostics.Append(setFromCapacityClaim(&state, claim, false)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+		result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+	})
+}
@@ -0,0 +1,63 @@
+package inference_test
+
+import (
+	"testing"
+
+	inferencev1 "buf.build/gen/go/coreweave/inference/protocolbuffers/go/coreweave/inference/v1alpha1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave/inference"
+	"github.com/coreweave/terraform-provider-coreweave/internal/testutil"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapacityClaimListResource_List(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.NewFakeClient(t)
+	ids := map[string]string{}
+	for _, name := range []string{"reserved-b", "spot", "reserved-a"} {
+		created, err := client.Inference.CreateCapacityClaim(t.Context(), connect.NewRequest(&inferencev1.CreateCapacityClaimRequest{
+			Name:      name,
+			Resources: &inferencev1.CapacityClaimResources{InstanceId: "gb200-4x", InstanceCount: 3, Zones: []string{"US-EAST-04A"}},
+		}))
+		require.NoError(t, err)
+		ids[name] = created.Msg.GetCapacityClaim().GetSpec().GetId()
+	}
+
+	tests := []struct {
+		name   string
+		config map[string]any
+		want   []string
+	}{
+		{name: "all capacity claims, sorted by name", want: []string{"reserved-a", "reserved-b", "spot"}},
+		{name: "by name regex", config: map[string]any{"name_regex": "^reserved-"}, want: []string{"reserved-a", "reserved-b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			results := testutil.ListResource(t, inference.NewCapacityClaimListResource(), inference.NewInferenceCapacityClaimResource(), client, tt.config, true, 0)
+
+			names := make([]string, len(results))
+			for i, result := range results {
+				require.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+				names[i] = result.DisplayName
+
+				var id types.String
+				require.False(t, result.Identity.GetAttribute(t.Context(), path.Root("id"), &id).HasError())
+				assert.Equal(t, ids[result.DisplayName], id.ValueString())
+
+				var state inference.InferenceCapacityClaimResourceModel
+				require.False(t, result.Resource.Get(t.Context(), &state).HasError())
+				assert.Equal(t, id, state.ID)
+				require.NotNil(t, state.Resources)
+				assert.Equal(t, "gb200-4x", state.Resources.InstanceType.ValueString())
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
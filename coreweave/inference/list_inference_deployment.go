@@ -0,0 +1,112 @@
+package inference
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	inferencev1 "buf.build/gen/go/coreweave/inference/protocolbuffers/go/coreweave/inference/v1alpha1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &DeploymentListResource{}
+	_ list.ListResourceWithConfigure = &DeploymentListResource{}
+)
+
+func NewDeploymentListResource() list.ListResource {
+	return &DeploymentListResource{}
+}
+
+// DeploymentListResource lists the existing deployments for `terraform query`, so that they can be imported.
+type DeploymentListResource struct {
+	client *coreweave.InferenceClient
+}
+
+type DeploymentListResourceModel struct {
+	GatewayID types.String `tfsdk:"gateway_id"`
+	NameRegex types.String `tfsdk:"name_regex"`
+}
+
+func (l *DeploymentListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inference_deployment"
+}
+
+func (l *DeploymentListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the existing [CoreWeave Managed Inference](https://docs.coreweave.com/products/inference) deployments, optionally filtered by gateway and name, to import them.",
+		Attributes: map[string]schema.Attribute{
+			"gateway_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the deployments associated with the [gateway](https://docs.coreweave.com/products/inference/gateways) with this ID.",
+			},
+			"name_regex": coreweave.ListNameRegexAttribute("deployments"),
+		},
+	}
+}
+
+func (l *DeploymentListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*coreweave.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *coreweave.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	l.client = client.Inference
+}
+
+func (l *DeploymentListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data DeploymentListResourceModel
+	diagnostics := req.Config.Get(ctx, &data)
+	nameRegex := coreweave.CompileNameRegex(data.NameRegex, &diagnostics)
+	if diagnostics.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+
+	// ListDeployments is not paginated: a single response holds every deployment of the organization, or of the gateway.
+	listResp, err := l.client.ListDeployments(ctx, connect.NewRequest(&inferencev1.ListDeploymentsRequest{
+		ParentGatewayId: data.GatewayID.ValueString(),
+	}))
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, &diagnostics)
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+
+	deployments := slices.DeleteFunc(slices.Clone(listResp.Msg.GetItems()), func(d *inferencev1.Deployment) bool {
+		return !coreweave.MatchesFilters(nameRegex, types.StringNull(), types.StringNull(), d.GetSpec().GetName(), "", "")
+	})
+	slices.SortFunc(deployments, func(a, b *inferencev1.Deployment) int {
+		return cmp.Or(cmp.Compare(a.GetSpec().GetName(), b.GetSpec().GetName()), cmp.Compare(a.GetSpec().GetId(), b.GetSpec().GetId()))
+	})
+
+	stream.Results = coreweave.ListResults(ctx, req, deployments, func(deployment *inferencev1.Deployment, result *list.ListResult) {
+		result.DisplayName = deployment.GetSpec().GetName()
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), deployment.GetSpec().GetId())...)
+		if !req.IncludeResource {
+			return
+		}
+
+		state := InferenceDeploymentResourceModel{Timeouts: coreweave.NullTimeouts(ctx)}
+		result.Diagnostics.Append(setFromDeployment(&state, deployment, false)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+		result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+	})
+}
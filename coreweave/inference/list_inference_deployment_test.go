@@ -0,0 +1,80 @@
+package inference_test
+
+import (
+	"testing"
+
+	inferencev1 "buf.build/gen/go/coreweave/inference/protocolbuffers/go/coreweave/inference/v1alpha1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave/inference"
+	"github.com/coreweave/terraform-provider-coreweave/internal/testutil"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentListResource_List(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.NewFakeClient(t)
+	gatewayIDs := map[string]string{}
+	for _, name := range []string{"public", "internal"} {
+		gateway, err := client.Inference.CreateGateway(t.Context(), connect.NewRequest(&inferencev1.CreateGatewayRequest{Name: name}))
+		require.NoError(t, err)
+		gatewayIDs[name] = gateway.Msg.GetGateway().GetSpec().GetId()
+	}
+	ids := map[string]string{}
+	for _, deployment := range []struct{ name, gateway string }{
+		{"llama-b", "public"},
+		{"qwen", "internal"},
+		{"llama-a", "internal"},
+	} {
+		created, err := client.Inference.CreateDeployment(t.Context(), connect.NewRequest(&inferencev1.CreateDeploymentRequest{
+			Name:       deployment.name,
+			GatewayIds: []string{gatewayIDs[deployment.gateway]},
+			Resources:  &inferencev1.DeploymentResources{InstanceType: "gd-8xh100ib-i128", GpuCount: 8},
+			Model:      &inferencev1.DeploymentModel{Name: deployment.name, Bucket: "models", Path: deployment.name + "/"},
+		}))
+		require.NoError(t, err)
+		ids[deployment.name] = created.Msg.GetDeployment().GetSpec().GetId()
+	}
+
+	tests := []struct {
+		name   string
+		config map[string]any
+		want   []string
+	}{
+		{name: "all deployments, sorted by name", want: []string{"llama-a", "llama-b", "qwen"}},
+		{
+			name:   "by gateway and name regex",
+			config: map[string]any{"gateway_id": gatewayIDs["internal"], "name_regex": "^llama-"},
+			want:   []string{"llama-a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			results := testutil.ListResource(t, inference.NewDeploymentListResource(), inference.NewInferenceDeploymentResource(), client, tt.config, true, 0)
+
+			names := make([]string, len(results))
+			for i, result := range results {
+				require.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+				names[i] = result.DisplayName
+
+				var id types.String
+				require.False(t, result.Identity.GetAttribute(t.Context(), path.Root("id"), &id).HasError())
+				assert.Equal(t, ids[result.DisplayName], id.ValueString())
+
+				var state inference.InferenceDeploymentResourceModel
+				require.False(t, result.Resource.Get(t.Context(), &state).HasError())
+				assert.Equal(t, id, state.ID)
+				require.NotNil(t, state.Model)
+				assert.Equal(t, result.DisplayName+"/", state.Model.Path.ValueString())
+				assert.True(t, state.Timeouts.IsNull())
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
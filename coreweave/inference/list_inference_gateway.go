@@ -0,0 +1,105 @@
+package inference
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	inferencev1 "buf.build/gen/go/coreweave/inference/protocolbuffers/go/coreweave/inference/v1alpha1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &GatewayListResource{}
+	_ list.ListResourceWithConfigure = &GatewayListResource{}
+)
+
+func NewGatewayListResource() list.ListResource {
+	return &GatewayListResource{}
+}
+
+// GatewayListResource lists the existing gateways for `terraform query`, so that they can be imported.
+type GatewayListResource struct {
+	client *coreweave.InferenceClient
+}
+
+type GatewayListResourceModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+}
+
+func (l *GatewayListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inference_gateway"
+}
+
+func (l *GatewayListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the existing [CoreWeave Managed Inference](https://docs.coreweave.com/products/inference) gateways, optionally filtered by name, to import them.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": coreweave.ListNameRegexAttribute("gateways"),
+		},
+	}
+}
+
+func (l *GatewayListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*coreweave.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *coreweave.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	l.client = client.Inference
+}
+
+func (l *GatewayListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data GatewayListResourceModel
+	diagnostics := req.Config.Get(ctx, &data)
+	nameRegex := coreweave.CompileNameRegex(data.NameRegex, &diagnostics)
+	if diagnostics.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+
+	// ListGateways is not paginated: a single response holds every gateway of the organization.
+	listResp, err := l.client.ListGateways(ctx, connect.NewRequest(&inferencev1.ListGatewaysRequest{}))
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, &diagnostics)
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+
+	gateways := slices.DeleteFunc(slices.Clone(listResp.Msg.GetItems()), func(gw *inferencev1.Gateway) bool {
+		return !coreweave.MatchesFilters(nameRegex, types.StringNull(), types.StringNull(), gw.GetSpec().GetName(), "", "")
+	})
+	slices.SortFunc(gateways, func(a, b *inferencev1.Gateway) int {
+		return cmp.Or(cmp.Compare(a.GetSpec().GetName(), b.GetSpec().GetName()), cmp.Compare(a.GetSpec().GetId(), b.GetSpec().GetId()))
+	})
+
+	stream.Results = coreweave.ListResults(ctx, req, gateways, func(gateway *inferencev1.Gateway, result *list.ListResult) {
+		result.DisplayName = gateway.GetSpec().GetName()
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), gateway.GetSpec().GetId())...)
+		if !req.IncludeResource {
+			return
+		}
+
+		state := InferenceGatewayResourceModel{Timeouts: coreweave.NullTimeouts(ctx)}
+		result.Diagnostics.Append(setFromGateway(&state, gateway, false)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+		result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+	})
+}
@@ -0,0 +1,64 @@
+package inference_test
+
+import (
+	"testing"
+
+	inferencev1 "buf.build/gen/go/coreweave/inference/protocolbuffers/go/coreweave/inference/v1alpha1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave/inference"
+	"github.com/coreweave/terraform-provider-coreweave/internal/testutil"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGatewayListResource_List(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.NewFakeClient(t)
+	ids := map[string]string{}
+	for _, name := range []string{"prod-b", "dev", "prod-a"} {
+		created, err := client.Inference.CreateGateway(t.Context(), connect.NewRequest(&inferencev1.CreateGatewayRequest{
+			Name:  name,
+			Zones: []string{"US-EAST-04A"},
+		}))
+		require.NoError(t, err)
+		ids[name] = created.Msg.GetGateway().GetSpec().GetId()
+	}
+
+	tests := []struct {
+		name   string
+		config map[string]any
+		want   []string
+	}{
+		{name: "all gateways, sorted by name", want: []string{"dev", "prod-a", "prod-b"}},
+		{name: "by name regex", config: map[string]any{"name_regex": "^prod-"}, want: []string{"prod-a", "prod-b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			results := testutil.ListResource(t, inference.NewGatewayListResource(), inference.NewInferenceGatewayResource(), client, tt.config, true, 0)
+
+			names := make([]string, len(results))
+			for i, result := range results {
+				require.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+				names[i] = result.DisplayName
+
+				var id types.String
+				require.False(t, result.Identity.GetAttribute(t.Context(), path.Root("id"), &id).HasError())
+				assert.Equal(t, ids[result.DisplayName], id.ValueString())
+
+				var state inference.InferenceGatewayResourceModel
+				require.False(t, result.Resource.Get(t.Context(), &state).HasError())
+				assert.Equal(t, id, state.ID)
+				var zones []string
+				require.False(t, state.Zones.ElementsAs(t.Context(), &zones, false).HasError())
+				assert.Equal(t, []string{"US-EAST-04A"}, zones)
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package inference_test
+
+import (
+	"testing"
+	"time"
+
+	inferencev1 "buf.build/gen/go/coreweave/inference/protocolbuffers/go/coreweave/inference/v1alpha1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave/fake"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave/inference"
+	"github.com/coreweave/terraform-provider-coreweave/internal/testutil"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCreatingDeployment creates a deployment named "llama" that is still CREATING in a fake API with the given options,
+// and returns a deployment resource configured with its client and the state of the deployment with the given timeouts.
+func newCreatingDeployment(t *testing.T, timeouts map[string]string, opts ...fake.Option) (*inference.InferenceDeploymentResource, inference.InferenceDeploymentResourceModel) {
+	t.Helper()
+
+	client := testutil.NewFakeClientWithOptions(t, append([]fake.Option{fake.WithDelay(time.Second)}, opts...)...)
+	gateway, err := client.Inference.CreateGateway(t.Context(), connect.NewRequest(&inferencev1.CreateGatewayRequest{Name: "public"}))
+	require.NoError(t, err)
+	created, err := client.Inference.CreateDeployment(t.Context(), connect.NewRequest(&inferencev1.CreateDeploymentRequest{
+		Name:       "llama",
+		GatewayIds: []string{gateway.Msg.GetGateway().GetSpec().GetId()},
+		Resources:  &inferencev1.DeploymentResources{InstanceType: "gd-8xh100ib-i128", GpuCount: 8},
+		Model:      &inferencev1.DeploymentModel{Name: "llama", Bucket: "models", Path: "llama/"},
+	}))
+	require.NoError(t, err)
+
+	state := inference.InferenceDeploymentResourceModel{Timeouts: testutil.Timeouts(t, timeouts)}
+	require.False(t, inference.SetFromDeployment(&state, created.Msg.GetDeployment(), false).HasError())
+	return testutil.ConfigureResource(t, &inference.InferenceDeploymentResource{}, client), state
+}
+
+func TestInferenceDeploymentResource_ReadPendingCreate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		timeouts    map[string]string
+		opts        []fake.Option
+		wantStatus  string
+		wantWarning string
+		wantPending bool
+	}{
+		{
+			name:       "resumes waiting for the create",
+			wantStatus: inferencev1.Status_STATUS_READY.String(),
+		},
+		{
+			name:        "warns once the create timeout has elapsed",
+			timeouts:    map[string]string{"create": "1ns"},
+			wantStatus:  inferencev1.Status_STATUS_CREATING.String(),
+			wantWarning: "Operation Still In Progress",
+			wantPending: true,
+		},
+		{
+			name:        "waits no longer than the read timeout",
+			timeouts:    map[string]string{"read": "100ms"},
+			wantStatus:  inferencev1.Status_STATUS_CREATING.String(),
+			wantWarning: "Operation Still In Progress",
+			wantPending: true,
+		},
+		{
+			name:        "warns when the resumed create fails",
+			opts:        []fake.Option{fake.WithFailingNames("llama")},
+			wantStatus:  inferencev1.Status_STATUS_FAILED.String(),
+			wantWarning: "Operation Failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			r, model := newCreatingDeployment(t, tt.timeouts, tt.opts...)
+			state, identity := testutil.ResourceState(t, r, &model)
+			req := resource.ReadRequest{State: state}
+			testutil.NewPrivateState(&req.Private)
+			require.Empty(t, coreweave.SetPendingOperation(ctx, req.Private, "create"))
+			resp := resource.ReadResponse{State: state, Identity: identity, Private: req.Private}
+			r.Read(ctx, req, &resp)
+
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			var got inference.InferenceDeploymentResourceModel
+			require.False(t, resp.State.Get(ctx, &got).HasError())
+			assert.Equal(t, tt.wantStatus, got.Status.ValueString())
+			op, diagnostics := coreweave.GetPendingOperation(ctx, resp.Private)
+			require.Empty(t, diagnostics)
+
+			if tt.wantWarning != "" {
+				require.Len(t, resp.Diagnostics.Warnings(), 1)
+				assert.Equal(t, tt.wantWarning, resp.Diagnostics.Warnings()[0].Summary())
+			} else {
+				assert.Empty(t, resp.Diagnostics)
+			}
+			if tt.wantPending {
+				assert.NotNil(t, op, "the create is still pending")
+			} else {
+				assert.Nil(t, op, "the create is no longer pending")
+			}
+		})
+	}
+}
+
+func TestInferenceDeploymentResource_ModifyPlanPendingOperation(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+
+	// the resource is not configured, so that the engine is not validated against the API
+	r := &inference.InferenceDeploymentResource{}
+	_, model := newCreatingDeployment(t, nil)
+	state, _ := testutil.ResourceState(t, r, &model)
+	model.Disabled = types.BoolValue(true)
+	plan, _ := testutil.ResourceState(t, r, &model)
+
+	req := resource.ModifyPlanRequest{State: state, Plan: tfsdk.Plan(plan)}
+	testutil.NewPrivateState(&req.Private)
+	require.Empty(t, coreweave.SetPendingOperation(ctx, req.Private, "update"))
+	resp := resource.ModifyPlanResponse{Plan: req.Plan, Private: req.Private}
+	r.ModifyPlan(ctx, req, &resp)
+
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	require.Len(t, resp.Diagnostics.Warnings(), 1)
+	assert.Equal(t, "Apply Waits For Pending Operation", resp.Diagnostics.Warnings()[0].Summary())
+}
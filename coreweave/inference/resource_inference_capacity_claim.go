@@ -9,6 +9,7 @@ import (
 	inferencev1 "buf.build/gen/go/coreweave/inference/protocolbuffers/go/coreweave/inference/v1alpha1"
 	"connectrpc.com/connect"
 	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -26,15 +28,31 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
 	_ resource.Resource                = &InferenceCapacityClaimResource{}
 	_ resource.ResourceWithImportState = &InferenceCapacityClaimResource{}
+	_ resource.ResourceWithIdentity    = &InferenceCapacityClaimResource{}
 
 	errCapacityClaimFailed = errors.New("inference capacity claim entered a failed state")
 )
 
+const (
+	defaultCapacityClaimCreateTimeout = 45 * time.Minute
+	defaultCapacityClaimReadTimeout   = 5 * time.Minute
+	defaultCapacityClaimUpdateTimeout = 20 * time.Minute
+	defaultCapacityClaimDeleteTimeout = 20 * time.Minute
+)
+
+// capacityClaimFieldPath maps API field paths onto the capacity claim schema, whose resources are not named as in
+// the request.
+var capacityClaimFieldPath = coreweave.FieldPathsWithin(map[string]bool{
+	"name":      true,
+	"resources": false,
+})
+
 func NewInferenceCapacityClaimResource() resource.Resource {
 	return &InferenceCapacityClaimResource{}
 }
@@ -66,13 +84,16 @@ type InferenceCapacityClaimResourceModel struct {
 	// Required
 	Name      types.String                 `tfsdk:"name"`
 	Resources *CapacityClaimResourcesModel `tfsdk:"resources"`
+
+	AdoptExisting types.Bool     `tfsdk:"adopt_existing"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *InferenceCapacityClaimResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_inference_capacity_claim"
 }
 
-func (r *InferenceCapacityClaimResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *InferenceCapacityClaimResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Create and manage [CoreWeave Managed Inference](https://docs.coreweave.com/products/inference) capacity claims for [Dedicated Inference](https://docs.coreweave.com/products/inference/dedicated). See [capacity claims](https://docs.coreweave.com/products/inference/scaling#capacity-claims) for capacity type details.",
 		Attributes: map[string]schema.Attribute{
@@ -183,6 +204,21 @@ func (r *InferenceCapacityClaimResource) Schema(_ context.Context, _ resource.Sc
 					},
 				},
 			},
+			"adopt_existing": coreweave.AdoptExistingAttribute("name"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *InferenceCapacityClaimResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique identifier of the capacity claim.",
+			},
 		},
 	}
 }
@@ -211,29 +247,47 @@ func (r *InferenceCapacityClaimResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCapacityClaimCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	createReq, diags := toCreateCapacityClaimRequest(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	var created *inferencev1.CapacityClaim
 	createResp, err := r.client.CreateCapacityClaim(ctx, connect.NewRequest(createReq))
-	if err != nil {
-		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+	switch {
+	case err == nil:
+		created = createResp.Msg.GetCapacityClaim()
+	case data.AdoptExisting.ValueBool() && coreweave.IsAlreadyExistsError(err):
+		if created = r.findAdoptable(ctx, createReq, &resp.Diagnostics); created == nil {
+			return
+		}
+	default:
+		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics, coreweave.WithFieldPaths(capacityClaimFieldPath))
 		return
 	}
 
 	// Save initial state before polling so the resource is tracked even if polling fails.
-	resp.Diagnostics.Append(setFromCapacityClaim(&data, createResp.Msg.GetCapacityClaim(), false)...)
+	resp.Diagnostics.Append(setFromCapacityClaim(&data, created, false)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("id"), data.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	claimID := createResp.Msg.GetCapacityClaim().GetSpec().GetId()
+	claimID := created.GetSpec().GetId()
 
 	conf := retry.StateChangeConf{
 		Pending: []string{
@@ -243,26 +297,25 @@ func (r *InferenceCapacityClaimResource) Create(ctx context.Context, req resourc
 		Target: []string{
 			inferencev1.Status_STATUS_READY.String(),
 		},
-		Refresh: func() (interface{}, string, error) {
-			getResp, err := r.client.GetCapacityClaim(ctx, connect.NewRequest(&inferencev1.GetCapacityClaimRequest{
-				Id: claimID,
-			}))
-			if err != nil {
-				tflog.Error(ctx, "failed to poll capacity claim", map[string]interface{}{"error": err.Error()})
-				return nil, inferencev1.Status_STATUS_UNSPECIFIED.String(), err
-			}
-			cc := getResp.Msg.GetCapacityClaim()
-			status := cc.GetStatus().GetStatus()
-			if status == inferencev1.Status_STATUS_ERROR || status == inferencev1.Status_STATUS_FAILED {
-				return cc, status.String(), errCapacityClaimFailed
-			}
-			return cc, status.String(), nil
-		},
-		Timeout:    45 * time.Minute,
+		Timeout:    createTimeout,
 		MinTimeout: 5 * time.Second,
 	}
 
-	raw, err := conf.WaitForStateContext(ctx)
+	raw, err := coreweave.WaitForState(ctx, "coreweave_inference_capacity_claim", "create", conf, func(ctx context.Context) (interface{}, string, error) {
+		getResp, err := r.client.GetCapacityClaim(ctx, connect.NewRequest(&inferencev1.GetCapacityClaimRequest{
+			Id: claimID,
+		}))
+		if err != nil {
+			tflog.Error(ctx, "failed to poll capacity claim", map[string]interface{}{"error": err.Error()})
+			return nil, inferencev1.Status_STATUS_UNSPECIFIED.String(), err
+		}
+		cc := getResp.Msg.GetCapacityClaim()
+		status := cc.GetStatus().GetStatus()
+		if status == inferencev1.Status_STATUS_ERROR || status == inferencev1.Status_STATUS_FAILED {
+			return cc, status.String(), errCapacityClaimFailed
+		}
+		return cc, status.String(), nil
+	})
 	if err != nil && !errors.Is(err, errCapacityClaimFailed) {
 		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
 		return
@@ -285,6 +338,29 @@ func (r *InferenceCapacityClaimResource) Create(ctx context.Context, req resourc
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// findAdoptable returns the capacity claim with the name of createReq, to adopt into state after creating it failed because it
+// already exists. It adds an error and returns nil if there is no such capacity claim, or it differs from createReq.
+func (r *InferenceCapacityClaimResource) findAdoptable(ctx context.Context, createReq *inferencev1.CreateCapacityClaimRequest, diagnostics *diag.Diagnostics) *inferencev1.CapacityClaim {
+	listResp, err := r.client.ListCapacityClaims(ctx, connect.NewRequest(&inferencev1.ListCapacityClaimsRequest{}))
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, diagnostics)
+		return nil
+	}
+
+	existing, ok := coreweave.FindAdoptable(diagnostics, "Capacity claim", createReq.GetName(), createReq, listResp.Msg.GetCapacityClaims(),
+		func(existing *inferencev1.CapacityClaim) bool {
+			return existing.GetSpec().GetName() == createReq.GetName()
+		},
+		func(existing *inferencev1.CapacityClaim) proto.Message { return existing.GetSpec() },
+	)
+	if !ok {
+		return nil
+	}
+
+	tflog.Info(ctx, "adopting existing capacity claim", map[string]interface{}{"id": existing.GetSpec().GetId()})
+	return existing
+}
+
 func (r *InferenceCapacityClaimResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data InferenceCapacityClaimResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -292,6 +368,15 @@ func (r *InferenceCapacityClaimResource) Read(ctx context.Context, req resource.
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultCapacityClaimReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	getResp, err := r.client.GetCapacityClaim(ctx, connect.NewRequest(&inferencev1.GetCapacityClaimRequest{
 		Id: data.ID.ValueString(),
 	}))
@@ -306,6 +391,7 @@ func (r *InferenceCapacityClaimResource) Read(ctx context.Context, req resource.
 
 	resp.Diagnostics.Append(setFromCapacityClaim(&data, getResp.Msg.GetCapacityClaim(), false)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("id"), data.ID)...)
 }
 
 func (r *InferenceCapacityClaimResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -315,6 +401,15 @@ func (r *InferenceCapacityClaimResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultCapacityClaimUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	updateReq, diags := toUpdateCapacityClaimRequest(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -323,7 +418,7 @@ func (r *InferenceCapacityClaimResource) Update(ctx context.Context, req resourc
 
 	updateResp, err := r.client.UpdateCapacityClaim(ctx, connect.NewRequest(updateReq))
 	if err != nil {
-		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics, coreweave.WithFieldPaths(capacityClaimFieldPath))
 		return
 	}
 
@@ -334,6 +429,7 @@ func (r *InferenceCapacityClaimResource) Update(ctx context.Context, req resourc
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("id"), data.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -349,26 +445,25 @@ func (r *InferenceCapacityClaimResource) Update(ctx context.Context, req resourc
 		Target: []string{
 			inferencev1.Status_STATUS_READY.String(),
 		},
-		Refresh: func() (interface{}, string, error) {
-			getResp, err := r.client.GetCapacityClaim(ctx, connect.NewRequest(&inferencev1.GetCapacityClaimRequest{
-				Id: claimID,
-			}))
-			if err != nil {
-				tflog.Error(ctx, "failed to poll capacity claim", map[string]interface{}{"error": err.Error()})
-				return nil, inferencev1.Status_STATUS_UNSPECIFIED.String(), err
-			}
-			cc := getResp.Msg.GetCapacityClaim()
-			status := cc.GetStatus().GetStatus()
-			if status == inferencev1.Status_STATUS_ERROR || status == inferencev1.Status_STATUS_FAILED {
-				return cc, status.String(), errCapacityClaimFailed
-			}
-			return cc, status.String(), nil
-		},
-		Timeout:    20 * time.Minute,
+		Timeout:    updateTimeout,
 		MinTimeout: 5 * time.Second,
 	}
 
-	raw, err := conf.WaitForStateContext(ctx)
+	raw, err := coreweave.WaitForState(ctx, "coreweave_inference_capacity_claim", "update", conf, func(ctx context.Context) (interface{}, string, error) {
+		getResp, err := r.client.GetCapacityClaim(ctx, connect.NewRequest(&inferencev1.GetCapacityClaimRequest{
+			Id: claimID,
+		}))
+		if err != nil {
+			tflog.Error(ctx, "failed to poll capacity claim", map[string]interface{}{"error": err.Error()})
+			return nil, inferencev1.Status_STATUS_UNSPECIFIED.String(), err
+		}
+		cc := getResp.Msg.GetCapacityClaim()
+		status := cc.GetStatus().GetStatus()
+		if status == inferencev1.Status_STATUS_ERROR || status == inferencev1.Status_STATUS_FAILED {
+			return cc, status.String(), errCapacityClaimFailed
+		}
+		return cc, status.String(), nil
+	})
 	if err != nil && !errors.Is(err, errCapacityClaimFailed) {
 		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
 		return
@@ -398,6 +493,15 @@ func (r *InferenceCapacityClaimResource) Delete(ctx context.Context, req resourc
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultCapacityClaimDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	claimID := data.ID.ValueString()
 
 	_, err := r.client.DeleteCapacityClaim(ctx, connect.NewRequest(&inferencev1.DeleteCapacityClaimRequest{
@@ -420,26 +524,25 @@ func (r *InferenceCapacityClaimResource) Delete(ctx context.Context, req resourc
 			inferencev1.Status_STATUS_DELETING.String(),
 			inferencev1.Status_STATUS_UNSPECIFIED.String(),
 		},
-		Target: []string{deletedState},
-		Refresh: func() (interface{}, string, error) {
-			getResp, err := r.client.GetCapacityClaim(ctx, connect.NewRequest(&inferencev1.GetCapacityClaimRequest{
-				Id: claimID,
-			}))
-			if err != nil {
-				if coreweave.IsNotFoundError(err) {
-					return struct{}{}, deletedState, nil
-				}
-				tflog.Error(ctx, "failed to poll capacity claim deletion", map[string]interface{}{"error": err.Error()})
-				return nil, inferencev1.Status_STATUS_UNSPECIFIED.String(), err
-			}
-			cc := getResp.Msg.GetCapacityClaim()
-			return cc, cc.GetStatus().GetStatus().String(), nil
-		},
-		Timeout:    20 * time.Minute,
+		Target:     []string{deletedState},
+		Timeout:    deleteTimeout,
 		MinTimeout: 5 * time.Second,
 	}
 
-	_, err = conf.WaitForStateContext(ctx)
+	_, err = coreweave.WaitForState(ctx, "coreweave_inference_capacity_claim", "delete", conf, func(ctx context.Context) (interface{}, string, error) {
+		getResp, err := r.client.GetCapacityClaim(ctx, connect.NewRequest(&inferencev1.GetCapacityClaimRequest{
+			Id: claimID,
+		}))
+		if err != nil {
+			if coreweave.IsNotFoundError(err) {
+				return struct{}{}, deletedState, nil
+			}
+			tflog.Error(ctx, "failed to poll capacity claim deletion", map[string]interface{}{"error": err.Error()})
+			return nil, inferencev1.Status_STATUS_UNSPECIFIED.String(), err
+		}
+		cc := getResp.Msg.GetCapacityClaim()
+		return cc, cc.GetStatus().GetStatus().String(), nil
+	})
 	if err != nil {
 		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
 		return
@@ -447,7 +550,7 @@ func (r *InferenceCapacityClaimResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *InferenceCapacityClaimResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // --- Helpers ---
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -12,6 +13,7 @@ import (
 	inferencev1 "buf.build/gen/go/coreweave/inference/protocolbuffers/go/coreweave/inference/v1alpha1"
 	"connectrpc.com/connect"
 	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -20,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -31,6 +34,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -51,6 +55,7 @@ var (
 	_ resource.Resource                = &InferenceDeploymentResource{}
 	_ resource.ResourceWithImportState = &InferenceDeploymentResource{}
 	_ resource.ResourceWithModifyPlan  = &InferenceDeploymentResource{}
+	_ resource.ResourceWithIdentity    = &InferenceDeploymentResource{}
 
 	hostnamePattern = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9\-]{0,61}[a-zA-Z0-9])?$`)
 	semverPattern   = regexp.MustCompile(
@@ -76,6 +81,34 @@ var (
 	errDeploymentFailed = errors.New("inference deployment entered a failed state")
 )
 
+const (
+	defaultDeploymentCreateTimeout = 45 * time.Minute
+	defaultDeploymentReadTimeout   = 5 * time.Minute
+	defaultDeploymentUpdateTimeout = 20 * time.Minute
+	defaultDeploymentDeleteTimeout = 20 * time.Minute
+)
+
+// deploymentPendingStatuses are the statuses of a deployment while an operation
+// on it is in progress, by operation.
+var deploymentPendingStatuses = map[string][]string{
+	"create": {
+		inferencev1.Status_STATUS_CREATING.String(),
+		inferencev1.Status_STATUS_UNSPECIFIED.String(),
+	},
+	"update": {
+		inferencev1.Status_STATUS_UPDATING.String(),
+		inferencev1.Status_STATUS_CREATING.String(),
+		inferencev1.Status_STATUS_UNSPECIFIED.String(),
+	},
+}
+
+// deploymentFailedStatuses are the statuses of a deployment whose operation
+// did not finish successfully.
+var deploymentFailedStatuses = []string{
+	inferencev1.Status_STATUS_ERROR.String(),
+	inferencev1.Status_STATUS_FAILED.String(),
+}
+
 // conditionsListFromStatus converts proto status conditions into the Terraform
 // list value shared by all inference resources.
 func conditionsListFromStatus(conds []*inferencev1.Condition) (types.List, diag.Diagnostics) {
@@ -101,6 +134,19 @@ func conditionsListFromStatus(conds []*inferencev1.Condition) (types.List, diag.
 	return condList, diagnostics
 }
 
+// deploymentFieldPath maps API field paths onto the deployment schema, which mirrors the request except that the
+// gateway IDs are a set.
+var deploymentFieldPath = coreweave.FieldPathsWithin(map[string]bool{
+	"name":        true,
+	"gateway_ids": false,
+	"runtime":     true,
+	"resources":   true,
+	"model":       true,
+	"autoscaling": true,
+	"traffic":     true,
+	"disabled":    true,
+})
+
 func NewInferenceDeploymentResource() resource.Resource {
 	return &InferenceDeploymentResource{}
 }
@@ -167,13 +213,16 @@ type InferenceDeploymentResourceModel struct {
 	Model       *DeploymentModelConfig `tfsdk:"model"`
 	Autoscaling *AutoscalingModel      `tfsdk:"autoscaling"`
 	Traffic     *TrafficModel          `tfsdk:"traffic"`
+
+	AdoptExisting types.Bool     `tfsdk:"adopt_existing"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *InferenceDeploymentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_inference_deployment"
 }
 
-func (r *InferenceDeploymentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *InferenceDeploymentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Create and manage [CoreWeave Managed Inference](https://docs.coreweave.com/products/inference) deployments. See the [getting started walkthrough](https://docs.coreweave.com/products/inference/getting-started) for the gateway-to-deployment flow.",
 		Attributes: map[string]schema.Attribute{
@@ -389,6 +438,21 @@ func (r *InferenceDeploymentResource) Schema(_ context.Context, _ resource.Schem
 					},
 				},
 			},
+			"adopt_existing": coreweave.AdoptExistingAttribute("name"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *InferenceDeploymentResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique identifier of the deployment.",
+			},
 		},
 	}
 }
@@ -416,12 +480,16 @@ func (r *InferenceDeploymentResource) Configure(_ context.Context, req resource.
 // keys of RuntimeParameters.RuntimeVersions returned by GetDeploymentParameters
 // — the same data the coreweave_inference_deployment_parameters data source
 // exposes. Adding an engine server-side thus makes it usable here with no
-// provider release.
+// provider release. It also warns when a change is planned while an operation
+// on the deployment is pending, as the apply waits for it first.
 func (r *InferenceDeploymentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	// No planned config on destroy — nothing to validate.
 	if req.Plan.Raw.IsNull() {
 		return
 	}
+	// The apply of a change waits for a pending operation to finish first.
+	coreweave.AddPendingOperationPlanWarning(ctx, req, resp, "deployment")
+
 	// `terraform validate` and other offline phases run before Configure; the
 	// API-backed check is simply skipped when no client is available.
 	if r.client == nil {
@@ -488,60 +556,86 @@ func (r *InferenceDeploymentResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultDeploymentCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	createReq, diags := toCreateRequest(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	var created *inferencev1.Deployment
 	createResp, err := r.client.CreateDeployment(ctx, connect.NewRequest(createReq))
-	if err != nil {
-		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+	switch {
+	case err == nil:
+		created = createResp.Msg.Deployment
+	case data.AdoptExisting.ValueBool() && coreweave.IsAlreadyExistsError(err):
+		if created = r.findAdoptable(ctx, createReq, &resp.Diagnostics); created == nil {
+			return
+		}
+	default:
+		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics, coreweave.WithFieldPaths(deploymentFieldPath))
 		return
 	}
 
 	// Save initial state before polling so the resource is tracked even if polling fails.
-	resp.Diagnostics.Append(setFromDeployment(&data, createResp.Msg.Deployment, false)...)
+	resp.Diagnostics.Append(setFromDeployment(&data, created, false)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("id"), data.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	deploymentID := createResp.Msg.Deployment.GetSpec().GetId()
+	// Record the create as pending, so that later runs resume waiting for it if
+	// this one is interrupted.
+	resp.Diagnostics.Append(coreweave.SetPendingOperation(ctx, resp.Private, "create")...)
+
+	deploymentID := created.GetSpec().GetId()
 
 	conf := retry.StateChangeConf{
-		Pending: []string{
-			inferencev1.Status_STATUS_CREATING.String(),
-			inferencev1.Status_STATUS_UNSPECIFIED.String(),
-		},
-		Target: []string{inferencev1.Status_STATUS_READY.String()},
-		Refresh: func() (interface{}, string, error) {
-			getResp, err := r.client.GetDeployment(ctx, connect.NewRequest(&inferencev1.GetDeploymentRequest{
-				Id: deploymentID,
-			}))
-			if err != nil {
-				tflog.Error(ctx, "failed to poll deployment", map[string]interface{}{"error": err.Error()})
-				return nil, inferencev1.Status_STATUS_UNSPECIFIED.String(), err
-			}
-			d := getResp.Msg.Deployment
-			status := d.GetStatus().GetStatus()
-			if status == inferencev1.Status_STATUS_ERROR || status == inferencev1.Status_STATUS_FAILED {
-				return d, status.String(), errDeploymentFailed
-			}
-			return d, status.String(), nil
-		},
-		Timeout:    45 * time.Minute,
+		Pending:    deploymentPendingStatuses["create"],
+		Target:     []string{inferencev1.Status_STATUS_READY.String()},
+		Timeout:    createTimeout,
 		MinTimeout: 5 * time.Second,
 	}
 
-	raw, err := conf.WaitForStateContext(ctx)
+	raw, err := coreweave.WaitForState(ctx, "coreweave_inference_deployment", "create", conf, func(ctx context.Context) (interface{}, string, error) {
+		getResp, err := r.client.GetDeployment(ctx, connect.NewRequest(&inferencev1.GetDeploymentRequest{
+			Id: deploymentID,
+		}))
+		if err != nil {
+			tflog.Error(ctx, "failed to poll deployment", map[string]interface{}{"error": err.Error()})
+			return nil, inferencev1.Status_STATUS_UNSPECIFIED.String(), err
+		}
+		d := getResp.Msg.Deployment
+		status := d.GetStatus().GetStatus()
+		if status == inferencev1.Status_STATUS_ERROR || status == inferencev1.Status_STATUS_FAILED {
+			return d, status.String(), errDeploymentFailed
+		}
+		return d, status.String(), nil
+	})
 	if err != nil && !errors.Is(err, errDeploymentFailed) {
+		if coreweave.IsInterrupted(err) {
+			// Failing would taint the deployment, so keep the create pending for
+			// the next run to resume waiting for it.
+			coreweave.AddInterruptedWarning(&resp.Diagnostics, "deployment", deploymentID, "create")
+			return
+		}
+		resp.Diagnostics.Append(coreweave.ClearPendingOperation(ctx, resp.Private)...)
 		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
 		return
 	}
+	resp.Diagnostics.Append(coreweave.ClearPendingOperation(ctx, resp.Private)...)
 
 	d, ok := raw.(*inferencev1.Deployment)
 	if !ok {
@@ -560,6 +654,29 @@ func (r *InferenceDeploymentResource) Create(ctx context.Context, req resource.C
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// findAdoptable returns the deployment with the name of createReq, to adopt into state after creating it failed because it
+// already exists. It adds an error and returns nil if there is no such deployment, or it differs from createReq.
+func (r *InferenceDeploymentResource) findAdoptable(ctx context.Context, createReq *inferencev1.CreateDeploymentRequest, diagnostics *diag.Diagnostics) *inferencev1.Deployment {
+	listResp, err := r.client.ListDeployments(ctx, connect.NewRequest(&inferencev1.ListDeploymentsRequest{}))
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, diagnostics)
+		return nil
+	}
+
+	existing, ok := coreweave.FindAdoptable(diagnostics, "Deployment", createReq.GetName(), createReq, listResp.Msg.GetItems(),
+		func(existing *inferencev1.Deployment) bool {
+			return existing.GetSpec().GetName() == createReq.GetName()
+		},
+		func(existing *inferencev1.Deployment) proto.Message { return existing.GetSpec() },
+	)
+	if !ok {
+		return nil
+	}
+
+	tflog.Info(ctx, "adopting existing deployment", map[string]interface{}{"id": existing.GetSpec().GetId()})
+	return existing
+}
+
 func (r *InferenceDeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data InferenceDeploymentResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -567,6 +684,21 @@ func (r *InferenceDeploymentResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultDeploymentReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	op, diags := coreweave.GetPendingOperation(ctx, resp.Private)
+	resp.Diagnostics.Append(diags...)
+	if op != nil && op.Operation == "create" {
+		r.resumePendingCreate(ctx, &data, op, readTimeout, &resp.Diagnostics)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	getResp, err := r.client.GetDeployment(ctx, connect.NewRequest(&inferencev1.GetDeploymentRequest{
 		Id: data.ID.ValueString(),
 	}))
@@ -579,8 +711,22 @@ func (r *InferenceDeploymentResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	if op != nil {
+		status := getResp.Msg.Deployment.GetStatus().GetStatus().String()
+		switch {
+		case slices.Contains(deploymentPendingStatuses[op.Operation], status):
+			coreweave.AddPendingOperationWarning(&resp.Diagnostics, "deployment", data.ID.ValueString(), op, status)
+		case slices.Contains(deploymentFailedStatuses, status):
+			coreweave.AddPendingOperationFailedWarning(&resp.Diagnostics, "deployment", data.ID.ValueString(), op, status)
+			resp.Diagnostics.Append(coreweave.ClearPendingOperation(ctx, resp.Private)...)
+		default:
+			resp.Diagnostics.Append(coreweave.ClearPendingOperation(ctx, resp.Private)...)
+		}
+	}
+
 	resp.Diagnostics.Append(setFromDeployment(&data, getResp.Msg.Deployment, false)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("id"), data.ID)...)
 }
 
 func (r *InferenceDeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -590,6 +736,20 @@ func (r *InferenceDeploymentResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultDeploymentUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	r.waitForPendingOperation(ctx, data.ID.ValueString(), updateTimeout, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	updateReq, diags := toUpdateRequest(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -598,7 +758,7 @@ func (r *InferenceDeploymentResource) Update(ctx context.Context, req resource.U
 
 	updateResp, err := r.client.UpdateDeployment(ctx, connect.NewRequest(updateReq))
 	if err != nil {
-		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics, coreweave.WithFieldPaths(deploymentFieldPath))
 		return
 	}
 
@@ -609,43 +769,44 @@ func (r *InferenceDeploymentResource) Update(ctx context.Context, req resource.U
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("id"), data.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Record the update as pending, so that later runs resume waiting for it if
+	// this one is interrupted.
+	resp.Diagnostics.Append(coreweave.SetPendingOperation(ctx, resp.Private, "update")...)
+
 	deploymentID := updateResp.Msg.Deployment.GetSpec().GetId()
 
 	conf := retry.StateChangeConf{
-		Pending: []string{
-			inferencev1.Status_STATUS_UPDATING.String(),
-			inferencev1.Status_STATUS_CREATING.String(),
-			inferencev1.Status_STATUS_UNSPECIFIED.String(),
-		},
-		Target: []string{inferencev1.Status_STATUS_READY.String()},
-		Refresh: func() (interface{}, string, error) {
-			getResp, err := r.client.GetDeployment(ctx, connect.NewRequest(&inferencev1.GetDeploymentRequest{
-				Id: deploymentID,
-			}))
-			if err != nil {
-				tflog.Error(ctx, "failed to poll deployment", map[string]interface{}{"error": err.Error()})
-				return nil, inferencev1.Status_STATUS_UNSPECIFIED.String(), err
-			}
-			d := getResp.Msg.Deployment
-			status := d.GetStatus().GetStatus()
-			if status == inferencev1.Status_STATUS_ERROR || status == inferencev1.Status_STATUS_FAILED {
-				return d, status.String(), errDeploymentFailed
-			}
-			return d, status.String(), nil
-		},
-		Timeout:    20 * time.Minute,
+		Pending:    deploymentPendingStatuses["update"],
+		Target:     []string{inferencev1.Status_STATUS_READY.String()},
+		Timeout:    updateTimeout,
 		MinTimeout: 5 * time.Second,
 	}
 
-	raw, err := conf.WaitForStateContext(ctx)
+	raw, err := coreweave.WaitForState(ctx, "coreweave_inference_deployment", "update", conf, func(ctx context.Context) (interface{}, string, error) {
+		getResp, err := r.client.GetDeployment(ctx, connect.NewRequest(&inferencev1.GetDeploymentRequest{
+			Id: deploymentID,
+		}))
+		if err != nil {
+			tflog.Error(ctx, "failed to poll deployment", map[string]interface{}{"error": err.Error()})
+			return nil, inferencev1.Status_STATUS_UNSPECIFIED.String(), err
+		}
+		d := getResp.Msg.Deployment
+		status := d.GetStatus().GetStatus()
+		if status == inferencev1.Status_STATUS_ERROR || status == inferencev1.Status_STATUS_FAILED {
+			return d, status.String(), errDeploymentFailed
+		}
+		return d, status.String(), nil
+	})
 	if err != nil && !errors.Is(err, errDeploymentFailed) {
 		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
 		return
 	}
+	resp.Diagnostics.Append(coreweave.ClearPendingOperation(ctx, resp.Private)...)
 
 	d, ok := raw.(*inferencev1.Deployment)
 	if !ok {
@@ -664,6 +825,93 @@ func (r *InferenceDeploymentResource) Update(ctx context.Context, req resource.U
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// resumePendingCreate resumes waiting for the create of the deployment in
+// data, if an earlier run was interrupted while waiting for it, for what is
+// left of its create timeout. It does not fail: the deployment is read
+// afterwards, with a warning if it is still being created.
+func (r *InferenceDeploymentResource) resumePendingCreate(ctx context.Context, data *InferenceDeploymentResourceModel, op *coreweave.PendingOperation, readTimeout time.Duration, diagnostics *diag.Diagnostics) {
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultDeploymentCreateTimeout)
+	diagnostics.Append(diags...)
+	// a read waits no longer than the read timeout, so that refreshing does not block for the rest of the create
+	remaining := min(op.Remaining(createTimeout), readTimeout)
+	if diags.HasError() || remaining <= 0 {
+		return
+	}
+
+	tflog.Info(ctx, "resuming wait for pending deployment create", map[string]interface{}{
+		"id":         data.ID.ValueString(),
+		"started_at": op.StartedAt,
+		"remaining":  remaining.String(),
+	})
+	err := r.waitForOperation(ctx, data.ID.ValueString(), "create", remaining)
+	switch {
+	case err == nil, coreweave.IsInterrupted(err), coreweave.IsTimeout(err), errors.Is(err, errDeploymentFailed):
+		// the read that follows reports whether the create is still pending or has failed
+	default:
+		coreweave.AddResumeFailedWarning(diagnostics, "deployment", data.ID.ValueString(), op, err)
+	}
+}
+
+// waitForPendingOperation resumes waiting for the pending operation of the
+// deployment with the given id, if an earlier run was interrupted while waiting
+// for it, so that the deployment is not changed while the operation is in
+// progress.
+func (r *InferenceDeploymentResource) waitForPendingOperation(ctx context.Context, id string, timeout time.Duration, private coreweave.PrivateState, diagnostics *diag.Diagnostics) {
+	op, diags := coreweave.GetPendingOperation(ctx, private)
+	diagnostics.Append(diags...)
+	if op == nil {
+		return
+	}
+
+	tflog.Info(ctx, "resuming wait for pending deployment operation", map[string]interface{}{
+		"id":         id,
+		"operation":  op.Operation,
+		"started_at": op.StartedAt,
+	})
+
+	err := r.waitForOperation(ctx, id, op.Operation, timeout)
+	if errors.Is(err, errDeploymentFailed) {
+		diagnostics.AddError("Deployment "+op.Operation+" failed",
+			"The deployment entered a failed status. Check the `conditions` attribute for details.")
+		return
+	}
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, diagnostics)
+		return
+	}
+
+	diagnostics.Append(coreweave.ClearPendingOperation(ctx, private)...)
+}
+
+// waitForOperation waits up to timeout for the operation on the deployment
+// with the given id to finish. It returns errDeploymentFailed if the
+// deployment enters a failed status instead.
+func (r *InferenceDeploymentResource) waitForOperation(ctx context.Context, id, operation string, timeout time.Duration) error {
+	conf := retry.StateChangeConf{
+		Pending:    deploymentPendingStatuses[operation],
+		Target:     []string{inferencev1.Status_STATUS_READY.String()},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := coreweave.WaitForState(ctx, "coreweave_inference_deployment", operation, conf, func(ctx context.Context) (interface{}, string, error) {
+		getResp, err := r.client.GetDeployment(ctx, connect.NewRequest(&inferencev1.GetDeploymentRequest{
+			Id: id,
+		}))
+		if err != nil {
+			tflog.Error(ctx, "failed to poll deployment", map[string]interface{}{"error": err.Error()})
+			return nil, inferencev1.Status_STATUS_UNSPECIFIED.String(), err
+		}
+		d := getResp.Msg.Deployment
+		status := d.GetStatus().GetStatus()
+		if status == inferencev1.Status_STATUS_ERROR || status == inferencev1.Status_STATUS_FAILED {
+			return d, status.String(), errDeploymentFailed
+		}
+		return d, status.String(), nil
+	})
+	return err
+}
+
 func (r *InferenceDeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data InferenceDeploymentResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -671,6 +919,15 @@ func (r *InferenceDeploymentResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeploymentDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	deploymentID := data.ID.ValueString()
 
 	_, err := r.client.DeleteDeployment(ctx, connect.NewRequest(&inferencev1.DeleteDeploymentRequest{
@@ -695,26 +952,25 @@ func (r *InferenceDeploymentResource) Delete(ctx context.Context, req resource.D
 			inferencev1.Status_STATUS_DELETING.String(),
 			inferencev1.Status_STATUS_UNSPECIFIED.String(),
 		},
-		Target: []string{deletedState},
-		Refresh: func() (interface{}, string, error) {
-			getResp, err := r.client.GetDeployment(ctx, connect.NewRequest(&inferencev1.GetDeploymentRequest{
-				Id: deploymentID,
-			}))
-			if err != nil {
-				if coreweave.IsNotFoundError(err) {
-					return struct{}{}, deletedState, nil
-				}
-				tflog.Error(ctx, "failed to poll deployment deletion", map[string]interface{}{"error": err.Error()})
-				return nil, inferencev1.Status_STATUS_UNSPECIFIED.String(), err
-			}
-			d := getResp.Msg.Deployment
-			return d, d.GetStatus().GetStatus().String(), nil
-		},
-		Timeout:    20 * time.Minute,
+		Target:     []string{deletedState},
+		Timeout:    deleteTimeout,
 		MinTimeout: 5 * time.Second,
 	}
 
-	_, err = conf.WaitForStateContext(ctx)
+	_, err = coreweave.WaitForState(ctx, "coreweave_inference_deployment", "delete", conf, func(ctx context.Context) (interface{}, string, error) {
+		getResp, err := r.client.GetDeployment(ctx, connect.NewRequest(&inferencev1.GetDeploymentRequest{
+			Id: deploymentID,
+		}))
+		if err != nil {
+			if coreweave.IsNotFoundError(err) {
+				return struct{}{}, deletedState, nil
+			}
+			tflog.Error(ctx, "failed to poll deployment deletion", map[string]interface{}{"error": err.Error()})
+			return nil, inferencev1.Status_STATUS_UNSPECIFIED.String(), err
+		}
+		d := getResp.Msg.Deployment
+		return d, d.GetStatus().GetStatus().String(), nil
+	})
 	if err != nil {
 		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
 		return
@@ -722,7 +978,7 @@ func (r *InferenceDeploymentResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *InferenceDeploymentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // --- Helpers ---
@@ -9,6 +9,7 @@ import (
 	inferencev1 "buf.build/gen/go/coreweave/inference/protocolbuffers/go/coreweave/inference/v1alpha1"
 	"connectrpc.com/connect"
 	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -24,16 +26,33 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
 	_ resource.Resource                     = &InferenceGatewayResource{}
 	_ resource.ResourceWithImportState      = &InferenceGatewayResource{}
 	_ resource.ResourceWithConfigValidators = &InferenceGatewayResource{}
+	_ resource.ResourceWithIdentity         = &InferenceGatewayResource{}
 
 	errGatewayFailed = errors.New("inference gateway entered a failed state")
 )
 
+const (
+	defaultGatewayCreateTimeout = 45 * time.Minute
+	defaultGatewayReadTimeout   = 5 * time.Minute
+	defaultGatewayUpdateTimeout = 20 * time.Minute
+	defaultGatewayDeleteTimeout = 20 * time.Minute
+)
+
+// gatewayFieldPath maps API field paths onto the gateway schema, which mirrors the request except that the zones
+// are a set and the endpoint configuration is split across the auth, routing and endpoint_configuration attributes.
+var gatewayFieldPath = coreweave.FieldPathsWithin(map[string]bool{
+	"name":                   true,
+	"zones":                  false,
+	"endpoint_configuration": false,
+})
+
 func NewInferenceGatewayResource() resource.Resource {
 	return &InferenceGatewayResource{}
 }
@@ -94,13 +113,16 @@ type InferenceGatewayResourceModel struct {
 	Auth                  *GatewayAuthModel           `tfsdk:"auth"`
 	Routing               *GatewayRoutingModel        `tfsdk:"routing"`
 	EndpointConfiguration *EndpointConfigurationModel `tfsdk:"endpoint_configuration"`
+
+	AdoptExisting types.Bool     `tfsdk:"adopt_existing"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *InferenceGatewayResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_inference_gateway"
 }
 
-func (r *InferenceGatewayResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *InferenceGatewayResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Create and manage [CoreWeave Managed Inference](https://docs.coreweave.com/products/inference) gateways. See [gateways](https://docs.coreweave.com/products/inference/gateways) for authentication and routing details.",
 		Attributes: map[string]schema.Attribute{
@@ -261,6 +283,10 @@ func (r *InferenceGatewayResource) Schema(_ context.Context, _ resource.SchemaRe
 					},
 				},
 			},
+			"adopt_existing": coreweave.AdoptExistingAttribute("name"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
 		},
 	}
 }
@@ -279,6 +305,17 @@ func (r *InferenceGatewayResource) ConfigValidators(_ context.Context) []resourc
 	}
 }
 
+func (r *InferenceGatewayResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique identifier of the gateway.",
+			},
+		},
+	}
+}
+
 func (r *InferenceGatewayResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -303,56 +340,73 @@ func (r *InferenceGatewayResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultGatewayCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	createReq, diags := toCreateGatewayRequest(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	var created *inferencev1.Gateway
 	createResp, err := r.client.CreateGateway(ctx, connect.NewRequest(createReq))
-	if err != nil {
-		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+	switch {
+	case err == nil:
+		created = createResp.Msg.Gateway
+	case data.AdoptExisting.ValueBool() && coreweave.IsAlreadyExistsError(err):
+		if created = r.findAdoptable(ctx, createReq, &resp.Diagnostics); created == nil {
+			return
+		}
+	default:
+		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics, coreweave.WithFieldPaths(gatewayFieldPath))
 		return
 	}
 
 	// Save initial state before polling so the resource is tracked even if polling fails.
-	resp.Diagnostics.Append(setFromGateway(&data, createResp.Msg.Gateway, false)...)
+	resp.Diagnostics.Append(setFromGateway(&data, created, false)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("id"), data.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	gatewayID := createResp.Msg.Gateway.GetSpec().GetId()
+	gatewayID := created.GetSpec().GetId()
 
 	conf := retry.StateChangeConf{
 		Pending: []string{
 			inferencev1.Status_STATUS_CREATING.String(),
 			inferencev1.Status_STATUS_UNSPECIFIED.String(),
 		},
-		Target: []string{inferencev1.Status_STATUS_READY.String()},
-		Refresh: func() (interface{}, string, error) {
-			getResp, err := r.client.GetGateway(ctx, connect.NewRequest(&inferencev1.GetGatewayRequest{
-				Id: gatewayID,
-			}))
-			if err != nil {
-				tflog.Error(ctx, "failed to poll gateway", map[string]interface{}{"error": err.Error()})
-				return nil, inferencev1.Status_STATUS_UNSPECIFIED.String(), err
-			}
-			gw := getResp.Msg.Gateway
-			status := gw.GetStatus().GetStatus()
-			if status == inferencev1.Status_STATUS_ERROR || status == inferencev1.Status_STATUS_FAILED {
-				return gw, status.String(), errGatewayFailed
-			}
-			return gw, status.String(), nil
-		},
-		Timeout:    45 * time.Minute,
+		Target:     []string{inferencev1.Status_STATUS_READY.String()},
+		Timeout:    createTimeout,
 		MinTimeout: 5 * time.Second,
 	}
 
-	raw, err := conf.WaitForStateContext(ctx)
+	raw, err := coreweave.WaitForState(ctx, "coreweave_inference_gateway", "create", conf, func(ctx context.Context) (interface{}, string, error) {
+		getResp, err := r.client.GetGateway(ctx, connect.NewRequest(&inferencev1.GetGatewayRequest{
+			Id: gatewayID,
+		}))
+		if err != nil {
+			tflog.Error(ctx, "failed to poll gateway", map[string]interface{}{"error": err.Error()})
+			return nil, inferencev1.Status_STATUS_UNSPECIFIED.String(), err
+		}
+		gw := getResp.Msg.Gateway
+		status := gw.GetStatus().GetStatus()
+		if status == inferencev1.Status_STATUS_ERROR || status == inferencev1.Status_STATUS_FAILED {
+			return gw, status.String(), errGatewayFailed
+		}
+		return gw, status.String(), nil
+	})
 	if err != nil && !errors.Is(err, errGatewayFailed) {
 		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
 		return
@@ -375,6 +429,29 @@ func (r *InferenceGatewayResource) Create(ctx context.Context, req resource.Crea
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// findAdoptable returns the gateway with the name of createReq, to adopt into state after creating it failed because it
+// already exists. It adds an error and returns nil if there is no such gateway, or it differs from createReq.
+func (r *InferenceGatewayResource) findAdoptable(ctx context.Context, createReq *inferencev1.CreateGatewayRequest, diagnostics *diag.Diagnostics) *inferencev1.Gateway {
+	listResp, err := r.client.ListGateways(ctx, connect.NewRequest(&inferencev1.ListGatewaysRequest{}))
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, diagnostics)
+		return nil
+	}
+
+	existing, ok := coreweave.FindAdoptable(diagnostics, "Gateway", createReq.GetName(), createReq, listResp.Msg.GetItems(),
+		func(existing *inferencev1.Gateway) bool {
+			return existing.GetSpec().GetName() == createReq.GetName()
+		},
+		func(existing *inferencev1.Gateway) proto.Message { return existing.GetSpec() },
+	)
+	if !ok {
+		return nil
+	}
+
+	tflog.Info(ctx, "adopting existing gateway", map[string]interface{}{"id": existing.GetSpec().GetId()})
+	return existing
+}
+
 func (r *InferenceGatewayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data InferenceGatewayResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -382,6 +459,15 @@ func (r *InferenceGatewayResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultGatewayReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	getResp, err := r.client.GetGateway(ctx, connect.NewRequest(&inferencev1.GetGatewayRequest{
 		Id: data.ID.ValueString(),
 	}))
@@ -396,6 +482,7 @@ func (r *InferenceGatewayResource) Read(ctx context.Context, req resource.ReadRe
 
 	resp.Diagnostics.Append(setFromGateway(&data, getResp.Msg.Gateway, false)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("id"), data.ID)...)
 }
 
 func (r *InferenceGatewayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -405,6 +492,15 @@ func (r *InferenceGatewayResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultGatewayUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	updateReq, diags := toUpdateGatewayRequest(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -413,7 +509,7 @@ func (r *InferenceGatewayResource) Update(ctx context.Context, req resource.Upda
 
 	updateResp, err := r.client.UpdateGateway(ctx, connect.NewRequest(updateReq))
 	if err != nil {
-		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics, coreweave.WithFieldPaths(gatewayFieldPath))
 		return
 	}
 
@@ -424,6 +520,7 @@ func (r *InferenceGatewayResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("id"), data.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -436,27 +533,26 @@ func (r *InferenceGatewayResource) Update(ctx context.Context, req resource.Upda
 			inferencev1.Status_STATUS_CREATING.String(),
 			inferencev1.Status_STATUS_UNSPECIFIED.String(),
 		},
-		Target: []string{inferencev1.Status_STATUS_READY.String()},
-		Refresh: func() (interface{}, string, error) {
-			getResp, err := r.client.GetGateway(ctx, connect.NewRequest(&inferencev1.GetGatewayRequest{
-				Id: gatewayID,
-			}))
-			if err != nil {
-				tflog.Error(ctx, "failed to poll gateway", map[string]interface{}{"error": err.Error()})
-				return nil, inferencev1.Status_STATUS_UNSPECIFIED.String(), err
-			}
-			gw := getResp.Msg.Gateway
-			status := gw.GetStatus().GetStatus()
-			if status == inferencev1.Status_STATUS_ERROR || status == inferencev1.Status_STATUS_FAILED {
-				return gw, status.String(), errGatewayFailed
-			}
-			return gw, status.String(), nil
-		},
-		Timeout:    20 * time.Minute,
+		Target:     []string{inferencev1.Status_STATUS_READY.String()},
+		Timeout:    updateTimeout,
 		MinTimeout: 5 * time.Second,
 	}
 
-	raw, err := conf.WaitForStateContext(ctx)
+	raw, err := coreweave.WaitForState(ctx, "coreweave_inference_gateway", "update", conf, func(ctx context.Context) (interface{}, string, error) {
+		getResp, err := r.client.GetGateway(ctx, connect.NewRequest(&inferencev1.GetGatewayRequest{
+			Id: gatewayID,
+		}))
+		if err != nil {
+			tflog.Error(ctx, "failed to poll gateway", map[string]interface{}{"error": err.Error()})
+			return nil, inferencev1.Status_STATUS_UNSPECIFIED.String(), err
+		}
+		gw := getResp.Msg.Gateway
+		status := gw.GetStatus().GetStatus()
+		if status == inferencev1.Status_STATUS_ERROR || status == inferencev1.Status_STATUS_FAILED {
+			return gw, status.String(), errGatewayFailed
+		}
+		return gw, status.String(), nil
+	})
 	if err != nil && !errors.Is(err, errGatewayFailed) {
 		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
 		return
@@ -486,6 +582,15 @@ func (r *InferenceGatewayResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultGatewayDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	gatewayID := data.ID.ValueString()
 
 	_, err := r.client.DeleteGateway(ctx, connect.NewRequest(&inferencev1.DeleteGatewayRequest{
@@ -510,26 +615,25 @@ func (r *InferenceGatewayResource) Delete(ctx context.Context, req resource.Dele
 			inferencev1.Status_STATUS_DELETING.String(),
 			inferencev1.Status_STATUS_UNSPECIFIED.String(),
 		},
-		Target: []string{deletedState},
-		Refresh: func() (interface{}, string, error) {
-			getResp, err := r.client.GetGateway(ctx, connect.NewRequest(&inferencev1.GetGatewayRequest{
-				Id: gatewayID,
-			}))
-			if err != nil {
-				if coreweave.IsNotFoundError(err) {
-					return struct{}{}, deletedState, nil
-				}
-				tflog.Error(ctx, "failed to poll gateway deletion", map[string]interface{}{"error": err.Error()})
-				return nil, inferencev1.Status_STATUS_UNSPECIFIED.String(), err
-			}
-			gw := getResp.Msg.Gateway
-			return gw, gw.GetStatus().GetStatus().String(), nil
-		},
-		Timeout:    20 * time.Minute,
+		Target:     []string{deletedState},
+		Timeout:    deleteTimeout,
 		MinTimeout: 5 * time.Second,
 	}
 
-	_, err = conf.WaitForStateContext(ctx)
+	_, err = coreweave.WaitForState(ctx, "coreweave_inference_gateway", "delete", conf, func(ctx context.Context) (interface{}, string, error) {
+		getResp, err := r.client.GetGateway(ctx, connect.NewRequest(&inferencev1.GetGatewayRequest{
+			Id: gatewayID,
+		}))
+		if err != nil {
+			if coreweave.IsNotFoundError(err) {
+				return struct{}{}, deletedState, nil
+			}
+			tflog.Error(ctx, "failed to poll gateway deletion", map[string]interface{}{"error": err.Error()})
+			return nil, inferencev1.Status_STATUS_UNSPECIFIED.String(), err
+		}
+		gw := getResp.Msg.Gateway
+		return gw, gw.GetStatus().GetStatus().String(), nil
+	})
 	if err != nil {
 		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
 		return
@@ -537,7 +641,7 @@ func (r *InferenceGatewayResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *InferenceGatewayResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // --- Helpers ---
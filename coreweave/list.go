@@ -0,0 +1,36 @@
+package coreweave
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NullTimeouts returns the null timeouts block of a resource whose schema uses timeouts.BlockAll, for state that is
+// built from an API object rather than from a plan or prior state, e.g. the resource of a list result.
+func NullTimeouts(ctx context.Context) timeouts.Value {
+	blockType, _ := timeouts.BlockAll(ctx).Type().(timeouts.Type)
+	return timeouts.Value{Object: types.ObjectNull(blockType.AttrTypes)}
+}
+
+// ListResults returns the results of a list resource for items, in order and up to the limit of req. set populates
+// the identity, display name and, if req.IncludeResource is set, the resource of the result for an item, and adds any
+// errors to the diagnostics of the result.
+func ListResults[T any](ctx context.Context, req list.ListRequest, items []T, set func(item T, result *list.ListResult)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i, item := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			set(item, &result)
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -3,18 +3,143 @@ package coreweave
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"reflect"
+	"strings"
 
 	"connectrpc.com/connect"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"google.golang.org/protobuf/encoding/prototext"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 const (
 	logMessageKey = "message"
+	logHeadersKey = "headers"
+
+	redactedValue = "<redacted>"
+)
+
+var (
+	// sensitiveFieldNames are the proto field names whose values are never logged, in addition to any field
+	// annotated with the standard `debug_redact` option.
+	sensitiveFieldNames = map[protoreflect.Name]bool{
+		"api_key":           true,
+		"password":          true,
+		"private_key":       true,
+		"secret_access_key": true,
+		"secret_key":        true,
+		"session_token":     true,
+		"token":             true,
+	}
+
+	// sensitiveHeaders are the (canonicalized) headers whose values are never logged or recorded.
+	sensitiveHeaders = map[string]bool{
+		"Authorization":        true,
+		"Cookie":               true,
+		"Proxy-Authorization":  true,
+		"Set-Cookie":           true,
+		"X-Amz-Security-Token": true,
+	}
 )
 
+// isSensitiveField reports whether the value of fd must be redacted from logs.
+func isSensitiveField(fd protoreflect.FieldDescriptor) bool {
+	if sensitiveFieldNames[fd.Name()] {
+		return true
+	}
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+	return ok && opts.GetDebugRedact()
+}
+
+// redactMessage returns message with the values of all sensitive fields, including those of nested messages,
+// replaced by a placeholder. The original message is never modified; it is cloned only if something needs redacting.
+func redactMessage(message proto.Message) proto.Message {
+	if message == nil || !needsRedaction(message.ProtoReflect()) {
+		return message
+	}
+
+	redacted := proto.Clone(message)
+	redactFields(redacted.ProtoReflect())
+	return redacted
+}
+
+func needsRedaction(m protoreflect.Message) bool {
+	found := false
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if isSensitiveField(fd) {
+			found = true
+		} else {
+			forEachMessage(fd, v, func(nested protoreflect.Message) {
+				found = found || needsRedaction(nested)
+			})
+		}
+		return !found
+	})
+	return found
+}
+
+func redactFields(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if !isSensitiveField(fd) {
+			forEachMessage(fd, v, redactFields)
+			return true
+		}
+
+		switch {
+		case fd.IsList() || fd.IsMap():
+			// there is no placeholder of the right type for a collection, so drop it entirely
+			m.Clear(fd)
+		case fd.Kind() == protoreflect.StringKind:
+			m.Set(fd, protoreflect.ValueOfString(redactedValue))
+		case fd.Kind() == protoreflect.BytesKind:
+			m.Set(fd, protoreflect.ValueOfBytes([]byte(redactedValue)))
+		default:
+			m.Clear(fd)
+		}
+		return true
+	})
+}
+
+// forEachMessage calls fn for every message held by the field fd with value v, whether singular, repeated or a map value.
+func forEachMessage(fd protoreflect.FieldDescriptor, v protoreflect.Value, fn func(protoreflect.Message)) {
+	switch {
+	case fd.IsList():
+		if fd.Message() == nil {
+			return
+		}
+		list := v.List()
+		for i := range list.Len() {
+			fn(list.Get(i).Message())
+		}
+	case fd.IsMap():
+		if fd.MapValue().Message() == nil {
+			return
+		}
+		v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+			fn(mv.Message())
+			return true
+		})
+	case fd.Message() != nil:
+		fn(v.Message())
+	}
+}
+
+// logFormatHeaders formats request headers for logging, with the values of sensitive headers redacted.
+func logFormatHeaders(headers http.Header) map[string]string {
+	formatted := make(map[string]string, len(headers))
+	for name, values := range headers {
+		if sensitiveHeaders[http.CanonicalHeaderKey(name)] {
+			formatted[name] = redactedValue
+			continue
+		}
+		formatted[name] = strings.Join(values, ", ")
+	}
+	return formatted
+}
+
 func tfLogBaseFields(req connect.AnyRequest) map[string]any {
 	return map[string]any{
 		"procedure":  req.Spec().Procedure,
@@ -23,19 +148,36 @@ func tfLogBaseFields(req connect.AnyRequest) map[string]any {
 	}
 }
 
+// logFormatMessage formats message as single-line prototext, with sensitive fields redacted.
 func logFormatMessage(message proto.Message) string {
 	return prototext.MarshalOptions{
 		Multiline:    false,
 		AllowPartial: true,
 		EmitUnknown:  true,
-	}.Format(message)
+	}.Format(redactMessage(message))
+}
+
+// messageOf returns the proto message of a unary connect.AnyRequest or connect.AnyResponse. This is tricky, because
+// neither exposes the underlying message directly, though unary requests and responses always have it, so reflection is
+// used to get to their Msg field.
+func messageOf(v any) (proto.Message, bool) {
+	value := reflect.ValueOf(v)
+	if !value.IsValid() || value.Kind() != reflect.Pointer || value.IsNil() {
+		return nil, false
+	}
+	field := value.Elem().FieldByName("Msg")
+	if !field.IsValid() {
+		return nil, false
+	}
+	msg, ok := field.Interface().(proto.Message)
+	return msg, ok
 }
 
 func tfLogRequest(ctx context.Context, req connect.AnyRequest) {
 	reqFields := tfLogBaseFields(req)
+	reqFields[logHeadersKey] = logFormatHeaders(req.Header())
 
-	// This is tricky, because AnyRequest does not expose the underlying proto message directly, but always has it (for unary requests).
-	if reqMsg, ok := reflect.ValueOf(req).Elem().FieldByName("Msg").Interface().(proto.Message); ok {
+	if reqMsg, ok := messageOf(req); ok {
 		reqFields[logMessageKey] = logFormatMessage(reqMsg)
 	} else {
 		tflog.Error(ctx, fmt.Sprintf("failed to get request message for logging; %T.Msg is not a proto.Message", req))
@@ -51,13 +193,12 @@ func tfLogResponse(ctx context.Context, req connect.AnyRequest, resp connect.Any
 		respFields["error"] = err.Error()
 	}
 
-	// Similarly to request, not knowing the type of AnyResponse means that we have to use reflection to get to the underlying proto message.
 	respValue := reflect.ValueOf(resp)
 	if !respValue.IsValid() || respValue.IsNil() {
 		tflog.Debug(ctx, "got nil or invalid API response", respFields)
 		// Special case, we can't get much more info out of it.
 		return
-	} else if respMsgAttr, ok := respValue.Elem().FieldByName("Msg").Interface().(proto.Message); ok {
+	} else if respMsgAttr, ok := messageOf(resp); ok {
 		respFields[logMessageKey] = logFormatMessage(respMsgAttr)
 	} else {
 		tflog.Error(ctx, fmt.Sprintf("failed to get response message for logging; %T.Msg is not a proto.Message", resp))
@@ -8,6 +8,8 @@ import (
 
 	"buf.build/gen/go/coreweave/cks/connectrpc/go/coreweave/cks/v1beta1/cksv1beta1connect"
 	cksv1beta1 "buf.build/gen/go/coreweave/cks/protocolbuffers/go/coreweave/cks/v1beta1"
+	"buf.build/gen/go/coreweave/inference/connectrpc/go/coreweave/inference/v1alpha1/inferencev1alpha1connect"
+	inferencev1alpha1 "buf.build/gen/go/coreweave/inference/protocolbuffers/go/coreweave/inference/v1alpha1"
 	"connectrpc.com/connect"
 	"github.com/coreweave/terraform-provider-coreweave/coreweave"
 	"github.com/hashicorp/terraform-plugin-log/tflogtest"
@@ -161,3 +163,53 @@ func TestTFLogInterceptor(t *testing.T) {
 		})
 	}
 }
+
+func TestTFLogInterceptor_Redaction(t *testing.T) {
+	t.Parallel()
+
+	const (
+		apiKey = "wandb-api-key-do-not-log"
+		token  = "CW-SECRET-do-not-log"
+	)
+
+	req := connect.NewRequest(&inferencev1alpha1.CreateGatewayRequest{
+		Name:  "test-gateway",
+		Zones: []string{"US-EAST-04A"},
+		Auth: &inferencev1alpha1.CreateGatewayRequest_WeightsAndBiasesAuth{
+			WeightsAndBiasesAuth: &inferencev1alpha1.WeightsAndBiasesAuth{
+				ApiKey:    apiKey,
+				ServerUrl: "https://wandb.example.com",
+			},
+		},
+	})
+	req.Header().Set("Authorization", "Bearer "+token)
+
+	var logbuf bytes.Buffer
+	ctx := tflogtest.RootLogger(t.Context(), &logbuf)
+
+	c := inferencev1alpha1connect.NewGatewayServiceClient(nil, "https://api.coreweave.com", connect.WithInterceptors(
+		coreweave.TFLogInterceptor(),
+		connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+			return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+				return connect.NewResponse(&inferencev1alpha1.CreateGatewayResponse{}), nil
+			}
+		}),
+	))
+	_, err := c.CreateGateway(ctx, req)
+	require.NoError(t, err)
+
+	assert.NotContains(t, logbuf.String(), apiKey)
+	assert.NotContains(t, logbuf.String(), token)
+
+	logEntries, err := tflogtest.MultilineJSONDecode(&logbuf)
+	require.NoError(t, err)
+	require.Len(t, logEntries, 2)
+
+	reqEntry := logEntries[0]
+	assert.Contains(t, reqEntry[logMessageKey], "<redacted>")
+	assert.Contains(t, reqEntry[logMessageKey], "https://wandb.example.com")
+	assert.Contains(t, reqEntry[logMessageKey], "test-gateway")
+	assert.Equal(t, "<redacted>", reqEntry["headers"].(map[string]any)["Authorization"])
+
+	assert.Equal(t, apiKey, req.Msg.GetWeightsAndBiasesAuth().GetApiKey(), "the request message itself must not be modified")
+}
@@ -11,13 +11,19 @@ import (
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ datasource.DataSource = &VpcDataSource{}
+	_ datasource.DataSource                     = &VpcDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &VpcDataSource{}
 )
 
 func NewVpcDataSource() datasource.DataSource {
@@ -35,113 +41,141 @@ func (d *VpcDataSource) Metadata(ctx context.Context, req datasource.MetadataReq
 }
 
 func (d *VpcDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := vpcDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The ID of the VPC. Exactly one of `id` or `name` must be set.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the VPC. Exactly one of `id` or `name` must be set. Looking a VPC up by name fails if there is no VPC with the name, or if there are several in different zones and `zone` is not set.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["zone"] = schema.StringAttribute{
+		MarkdownDescription: "The Availability Zone in which the VPC is located. When looking a VPC up by `name`, only the VPCs in this zone are considered.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(path.MatchRoot("id")),
+		},
+	}
+
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Query information about an existing VPC by ID. See the [CoreWeave VPC API reference](https://docs.coreweave.com/products/networking/vpc/vpc-api).",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the VPC.",
-				Required:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the VPC.",
-				Computed:            true,
-			},
-			"zone": schema.StringAttribute{
-				MarkdownDescription: "The Availability Zone in which the VPC is located.",
-				Computed:            true,
-			},
-			"vpc_prefixes": schema.ListNestedAttribute{
-				MarkdownDescription: "A list of additional named IPv4 prefixes for the VPC.",
-				Computed:            true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							Computed: true,
-						},
-						"value": schema.StringAttribute{
-							Computed: true,
-						},
+		MarkdownDescription: "Query information about an existing VPC by ID, or by name and zone. See the [CoreWeave VPC API reference](https://docs.coreweave.com/products/networking/vpc/vpc-api).",
+		Attributes:          attributes,
+	}
+}
+
+func (d *VpcDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+// vpcDataSourceAttributes returns the attributes of a VPC other than id, which is required by the data source of a
+// single VPC but computed for the items of the data source that lists them.
+func vpcDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the VPC.",
+			Computed:            true,
+		},
+		"zone": schema.StringAttribute{
+			MarkdownDescription: "The Availability Zone in which the VPC is located.",
+			Computed:            true,
+		},
+		"vpc_prefixes": schema.ListNestedAttribute{
+			MarkdownDescription: "A list of additional named IPv4 prefixes for the VPC.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Computed: true,
 					},
-				},
-			},
-			"host_prefix": schema.StringAttribute{
-				MarkdownDescription: "An IPv4 CIDR range used to allocate host addresses when booting compute into a VPC.",
-				DeprecationMessage:  "Configure host_prefixes instead.",
-				Computed:            true,
-			},
-			"host_prefixes": schema.SetNestedAttribute{
-				MarkdownDescription: "The IPv4 or IPv6 CIDR ranges used to allocate host addresses when booting compute into a VPC.",
-				Computed:            true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							MarkdownDescription: "The user-specified name of the host prefix.",
-							Computed:            true,
-						},
-						"type": schema.StringAttribute{
-							MarkdownDescription: "Controls network connectivity from the prefix to the host.",
-							Computed:            true,
-						},
-						"prefixes": schema.ListAttribute{
-							MarkdownDescription: "The VPC-wide aggregates from which host-specific prefixes are allocated. May be IPv4 or IPv6.",
-							ElementType:         cidrtypes.IPPrefixType{},
-							Computed:            true,
-						},
-						"ipam": schema.SingleNestedAttribute{
-							MarkdownDescription: "The configuration for a secondary host prefix.",
-							Computed:            true,
-							Attributes: map[string]schema.Attribute{
-								"prefix_length": schema.Int32Attribute{
-									MarkdownDescription: "The desired length for each Node's allocation from the VPC-wide aggregate prefix.",
-									Computed:            true,
-								},
-								"gateway_address_policy": schema.StringAttribute{
-									MarkdownDescription: "Describes which IP address from the prefix is allocated to the network gateway.",
-									Computed:            true,
-								},
-							},
-						},
+					"value": schema.StringAttribute{
+						Computed: true,
 					},
 				},
 			},
-			"ingress": schema.SingleNestedAttribute{
-				MarkdownDescription: "Settings affecting traffic entering the VPC.",
-				Computed:            true,
+		},
+		"host_prefix": schema.StringAttribute{
+			MarkdownDescription: "An IPv4 CIDR range used to allocate host addresses when booting compute into a VPC.",
+			DeprecationMessage:  "Configure host_prefixes instead.",
+			Computed:            true,
+		},
+		"host_prefixes": schema.SetNestedAttribute{
+			MarkdownDescription: "The IPv4 or IPv6 CIDR ranges used to allocate host addresses when booting compute into a VPC.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
 				Attributes: map[string]schema.Attribute{
-					"disable_public_services": schema.BoolAttribute{
-						MarkdownDescription: "True if the VPC will prevent public prefixes advertised from Nodes from being imported into public-facing networks, making them inaccessible from the Internet. False otherwise.",
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The user-specified name of the host prefix.",
 						Computed:            true,
 					},
-				},
-			},
-			"egress": schema.SingleNestedAttribute{
-				MarkdownDescription: "Settings affecting traffic leaving the VPC.",
-				Computed:            true,
-				Attributes: map[string]schema.Attribute{
-					"disable_public_access": schema.BoolAttribute{
-						MarkdownDescription: "True if the VPC is blocked from consuming public Internet. False otherwise.",
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Controls network connectivity from the prefix to the host.",
 						Computed:            true,
 					},
-				},
-			},
-			"dhcp": schema.SingleNestedAttribute{
-				MarkdownDescription: "Settings affecting DHCP behavior within the VPC.",
-				Computed:            true,
-				Attributes: map[string]schema.Attribute{
-					"dns": schema.SingleNestedAttribute{
-						MarkdownDescription: "Settings affecting DNS for DHCP within the VPC",
+					"prefixes": schema.ListAttribute{
+						MarkdownDescription: "The VPC-wide aggregates from which host-specific prefixes are allocated. May be IPv4 or IPv6.",
+						ElementType:         cidrtypes.IPPrefixType{},
+						Computed:            true,
+					},
+					"ipam": schema.SingleNestedAttribute{
+						MarkdownDescription: "The configuration for a secondary host prefix.",
 						Computed:            true,
 						Attributes: map[string]schema.Attribute{
-							"servers": schema.SetAttribute{
-								Optional:            true,
-								MarkdownDescription: "The DNS servers advertised to DHCP clients within the VPC.",
-								ElementType:         types.StringType,
+							"prefix_length": schema.Int32Attribute{
+								MarkdownDescription: "The desired length for each Node's allocation from the VPC-wide aggregate prefix.",
+								Computed:            true,
+							},
+							"gateway_address_policy": schema.StringAttribute{
+								MarkdownDescription: "Describes which IP address from the prefix is allocated to the network gateway.",
+								Computed:            true,
 							},
 						},
 					},
 				},
 			},
 		},
+		"ingress": schema.SingleNestedAttribute{
+			MarkdownDescription: "Settings affecting traffic entering the VPC.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"disable_public_services": schema.BoolAttribute{
+					MarkdownDescription: "True if the VPC will prevent public prefixes advertised from Nodes from being imported into public-facing networks, making them inaccessible from the Internet. False otherwise.",
+					Computed:            true,
+				},
+			},
+		},
+		"egress": schema.SingleNestedAttribute{
+			MarkdownDescription: "Settings affecting traffic leaving the VPC.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"disable_public_access": schema.BoolAttribute{
+					MarkdownDescription: "True if the VPC is blocked from consuming public Internet. False otherwise.",
+					Computed:            true,
+				},
+			},
+		},
+		"dhcp": schema.SingleNestedAttribute{
+			MarkdownDescription: "Settings affecting DHCP behavior within the VPC.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"dns": schema.SingleNestedAttribute{
+					MarkdownDescription: "Settings affecting DNS for DHCP within the VPC",
+					Computed:            true,
+					Attributes: map[string]schema.Attribute{
+						"servers": schema.SetAttribute{
+							Optional:            true,
+							MarkdownDescription: "The DNS servers advertised to DHCP clients within the VPC.",
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -171,18 +205,40 @@ func (d *VpcDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		return
 	}
 
-	cluster, err := d.client.GetVPC(ctx, connect.NewRequest(&networkingv1beta1.GetVPCRequest{
-		Id: data.Id.ValueString(),
-	}))
-	if err != nil {
-		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+	var vpc *networkingv1beta1.VPC
+	if !data.Id.IsNull() {
+		getResp, err := d.client.GetVPC(ctx, connect.NewRequest(&networkingv1beta1.GetVPCRequest{
+			Id: data.Id.ValueString(),
+		}))
+		if err != nil {
+			coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+			return
+		}
+		vpc = getResp.Msg.Vpc
+	} else if vpc = d.findByName(ctx, data, &resp.Diagnostics); vpc == nil {
 		return
 	}
 
-	data.Set(cluster.Msg.Vpc)
+	data.Set(vpc)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
+// findByName returns the VPC with the name, and zone if set, of data. It adds an error and returns nil if there is no
+// such VPC, or more than one.
+func (d *VpcDataSource) findByName(ctx context.Context, data *VpcDataSourceModel, diagnostics *diag.Diagnostics) *networkingv1beta1.VPC {
+	listResp, err := d.client.ListVPCs(ctx, connect.NewRequest(&networkingv1beta1.ListVPCsRequest{}))
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, diagnostics)
+		return nil
+	}
+
+	vpc, _ := coreweave.FindByName(diagnostics, "VPC", data.Name.ValueString(), data.Zone, listResp.Msg.Items,
+		func(vpc *networkingv1beta1.VPC) string { return vpc.Name },
+		func(vpc *networkingv1beta1.VPC) string { return vpc.Zone },
+	)
+	return vpc
+}
+
 func MustRenderVpcDataSource(_ context.Context, resourceName string, cluster *VpcDataSourceModel) string {
 	file := hclwrite.NewEmptyFile()
 	body := file.Body()
@@ -190,7 +246,14 @@ func MustRenderVpcDataSource(_ context.Context, resourceName string, cluster *Vp
 	resource := body.AppendNewBlock("data", []string{"coreweave_networking_vpc", resourceName})
 	resourceBody := resource.Body()
 
-	resourceBody.SetAttributeRaw("id", hclwrite.Tokens{{Type: hclsyntax.TokenIdent, Bytes: []byte(cluster.Id.ValueString())}})
+	if !cluster.Id.IsNull() {
+		resourceBody.SetAttributeRaw("id", hclwrite.Tokens{{Type: hclsyntax.TokenIdent, Bytes: []byte(cluster.Id.ValueString())}})
+	} else {
+		resourceBody.SetAttributeRaw("name", hclwrite.Tokens{{Type: hclsyntax.TokenIdent, Bytes: []byte(cluster.Name.ValueString())}})
+		if !cluster.Zone.IsNull() {
+			resourceBody.SetAttributeRaw("zone", hclwrite.Tokens{{Type: hclsyntax.TokenIdent, Bytes: []byte(cluster.Zone.ValueString())}})
+		}
+	}
 
 	var buf bytes.Buffer
 	if _, err := file.WriteTo(&buf); err != nil {
@@ -0,0 +1,60 @@
+package networking_test
+
+import (
+	"testing"
+
+	networkingv1beta1 "buf.build/gen/go/coreweave/networking/protocolbuffers/go/coreweave/networking/v1beta1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave/networking"
+	"github.com/coreweave/terraform-provider-coreweave/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVpcDataSource_ReadByName(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.NewFakeClient(t)
+	ids := map[string]string{}
+	for _, vpc := range []*networkingv1beta1.CreateVPCRequest{
+		{Name: "shared", Zone: "US-EAST-04A"},
+		{Name: "shared", Zone: "US-WEST-01A"},
+		{Name: "unique", Zone: "US-EAST-04A"},
+	} {
+		created, err := client.CreateVPC(t.Context(), connect.NewRequest(vpc))
+		require.NoError(t, err)
+		ids[vpc.Name+"/"+vpc.Zone] = created.Msg.GetVpc().GetId()
+	}
+
+	tests := []struct {
+		name        string
+		config      map[string]any
+		want        string
+		wantSummary string
+	}{
+		{name: "by id", config: map[string]any{"id": ids["shared/US-EAST-04A"]}, want: "shared/US-EAST-04A"},
+		{name: "by unique name", config: map[string]any{"name": "unique"}, want: "unique/US-EAST-04A"},
+		{name: "by name and zone", config: map[string]any{"name": "shared", "zone": "US-EAST-04A"}, want: "shared/US-EAST-04A"},
+		{name: "ambiguous name", config: map[string]any{"name": "shared"}, wantSummary: "Ambiguous VPC Name"},
+		{name: "missing name", config: map[string]any{"name": "missing"}, wantSummary: "VPC Not Found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state, diagnostics := testutil.ReadDataSource(t, networking.NewVpcDataSource(), client, tt.config)
+			if tt.wantSummary != "" {
+				require.True(t, diagnostics.HasError())
+				assert.Equal(t, tt.wantSummary, diagnostics[0].Summary())
+				return
+			}
+			require.False(t, diagnostics.HasError(), diagnostics)
+
+			var data networking.VpcDataSourceModel
+			require.False(t, state.Get(t.Context(), &data).HasError())
+			assert.Equal(t, tt.want, data.Name.ValueString()+"/"+data.Zone.ValueString())
+			assert.Equal(t, ids[tt.want], data.Id.ValueString())
+		})
+	}
+}
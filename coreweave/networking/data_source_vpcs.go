@@ -0,0 +1,140 @@
+package networking
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	networkingv1beta1 "buf.build/gen/go/coreweave/networking/protocolbuffers/go/coreweave/networking/v1beta1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &VpcsDataSource{}
+)
+
+func NewVpcsDataSource() datasource.DataSource {
+	return &VpcsDataSource{}
+}
+
+type VpcsDataSource struct {
+	client *coreweave.Client
+}
+
+type VpcsDataSourceModel struct {
+	Zone      types.String        `tfsdk:"zone"`
+	NameRegex types.String        `tfsdk:"name_regex"`
+	Status    types.String        `tfsdk:"status"`
+	Vpcs      []VpcsDataSourceVpc `tfsdk:"vpcs"`
+}
+
+// VpcsDataSourceVpc is a VPC listed by the coreweave_networking_vpcs data source, which also has the status that it
+// can be filtered by.
+type VpcsDataSourceVpc struct {
+	VpcDataSourceModel
+	Status types.String `tfsdk:"status"`
+}
+
+func (d *VpcsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networking_vpcs"
+}
+
+func (d *VpcsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	vpcAttributes := vpcDataSourceAttributes()
+	vpcAttributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The ID of the VPC.",
+		Computed:            true,
+	}
+	vpcAttributes["status"] = schema.StringAttribute{
+		MarkdownDescription: "The status of the VPC.",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the existing VPCs, optionally filtered by zone, name and status. See the [CoreWeave VPC API reference](https://docs.coreweave.com/products/networking/vpc/vpc-api).",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "Only list the VPCs in this Availability Zone.",
+				Optional:            true,
+			},
+			"name_regex": coreweave.NameRegexAttribute("VPCs"),
+			"status": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Only list the VPCs with this status. Must be one of %s.", coreweave.EnumMarkdownValues(networkingv1beta1.VPC_Status_name, true)),
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(coreweave.EnumValues(networkingv1beta1.VPC_Status_name, true)...),
+				},
+			},
+			"vpcs": schema.ListNestedAttribute{
+				MarkdownDescription: "The VPCs that match the filters, sorted by name and zone.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: vpcAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *VpcsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*coreweave.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *coreweave.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VpcsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	data := new(VpcsDataSourceModel)
+	resp.Diagnostics.Append(req.Config.Get(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegex := coreweave.CompileNameRegex(data.NameRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ListVPCs is not paginated: a single response holds every VPC of the organization.
+	listResp, err := d.client.ListVPCs(ctx, connect.NewRequest(&networkingv1beta1.ListVPCsRequest{}))
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+		return
+	}
+
+	vpcs := slices.DeleteFunc(slices.Clone(listResp.Msg.Items), func(vpc *networkingv1beta1.VPC) bool {
+		return !coreweave.MatchesFilters(nameRegex, data.Zone, data.Status, vpc.Name, vpc.Zone, vpc.Status.String())
+	})
+	slices.SortFunc(vpcs, func(a, b *networkingv1beta1.VPC) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Zone, b.Zone), cmp.Compare(a.Id, b.Id))
+	})
+
+	data.Vpcs = make([]VpcsDataSourceVpc, len(vpcs))
+	for i, vpc := range vpcs {
+		resp.Diagnostics.Append(data.Vpcs[i].Set(vpc)...)
+		data.Vpcs[i].Status = types.StringValue(vpc.Status.String())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
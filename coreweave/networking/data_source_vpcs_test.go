@@ -0,0 +1,66 @@
+package networking_test
+
+import (
+	"testing"
+
+	networkingv1beta1 "buf.build/gen/go/coreweave/networking/protocolbuffers/go/coreweave/networking/v1beta1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave/networking"
+	"github.com/coreweave/terraform-provider-coreweave/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVpcsDataSource_Read(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.NewFakeClient(t)
+	for _, vpc := range []*networkingv1beta1.CreateVPCRequest{
+		{Name: "shared", Zone: "US-EAST-04A", VpcPrefixes: []*networkingv1beta1.Prefix{{Name: "pod-cidr", Value: "10.0.0.0/16"}}},
+		{Name: "shared", Zone: "US-WEST-01A"},
+		{Name: "scratch", Zone: "US-EAST-04A"},
+	} {
+		_, err := client.CreateVPC(t.Context(), connect.NewRequest(vpc))
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name   string
+		config map[string]any
+		want   []string
+	}{
+		{
+			name: "all VPCs, sorted by name",
+			want: []string{"scratch/US-EAST-04A", "shared/US-EAST-04A", "shared/US-WEST-01A"},
+		},
+		{
+			name:   "by zone and name regex",
+			config: map[string]any{"zone": "US-EAST-04A", "name_regex": "^sh"},
+			want:   []string{"shared/US-EAST-04A"},
+		},
+		{
+			name:   "by status",
+			config: map[string]any{"status": networkingv1beta1.VPC_STATUS_DELETING.String()},
+			want:   []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state, diagnostics := testutil.ReadDataSource(t, networking.NewVpcsDataSource(), client, tt.config)
+			require.False(t, diagnostics.HasError(), diagnostics)
+
+			var data networking.VpcsDataSourceModel
+			require.False(t, state.Get(t.Context(), &data).HasError())
+
+			vpcs := make([]string, len(data.Vpcs))
+			for i, vpc := range data.Vpcs {
+				vpcs[i] = vpc.Name.ValueString() + "/" + vpc.Zone.ValueString()
+				assert.Equal(t, networkingv1beta1.VPC_STATUS_READY.String(), vpc.Status.ValueString())
+			}
+			assert.Equal(t, tt.want, vpcs)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package networking
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestVpcFieldPath(t *testing.T) {
+	tests := map[string]path.Path{
+		"name":                            path.Root("name"),
+		"spec.zone":                       path.Root("zone"),
+		"ingress.disable_public_services": path.Root("ingress").AtName("disable_public_services"),
+		"host_prefixes[0].prefixes[1]":    path.Root("host_prefixes"),
+		"vpc_prefixes[0].value":           path.Root("vpc_prefixes"),
+		"spec.vpc_prefixes[1].name":       path.Root("vpc_prefixes"),
+		"dhcp.dns.servers[1]":             path.Root("dhcp"),
+	}
+
+	for field, want := range tests {
+		t.Run(field, func(t *testing.T) {
+			got, ok := vpcFieldPath(field)
+			if !ok {
+				t.Fatalf("vpcFieldPath(%q) returned no path", field)
+			}
+			if !got.Equal(want) {
+				t.Errorf("vpcFieldPath(%q) = %s, want %s", field, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package networking
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	networkingv1beta1 "buf.build/gen/go/coreweave/networking/protocolbuffers/go/coreweave/networking/v1beta1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &VpcListResource{}
+	_ list.ListResourceWithConfigure = &VpcListResource{}
+)
+
+func NewVpcListResource() list.ListResource {
+	return &VpcListResource{}
+}
+
+// VpcListResource lists the existing VPCs for `terraform query`, so that they can be imported.
+type VpcListResource struct {
+	client *coreweave.Client
+}
+
+type VpcListResourceModel struct {
+	Zone      types.String `tfsdk:"zone"`
+	NameRegex types.String `tfsdk:"name_regex"`
+}
+
+func (l *VpcListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networking_vpc"
+}
+
+func (l *VpcListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the existing VPCs, optionally filtered by zone and name, to import them.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "Only list the VPCs in this Availability Zone.",
+				Optional:            true,
+			},
+			"name_regex": coreweave.ListNameRegexAttribute("VPCs"),
+		},
+	}
+}
+
+func (l *VpcListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*coreweave.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *coreweave.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	l.client = client
+}
+
+func (l *VpcListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data VpcListResourceModel
+	diagnostics := req.Config.Get(ctx, &data)
+	nameRegex := coreweave.CompileNameRegex(data.NameRegex, &diagnostics)
+	if diagnostics.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+
+	// ListVPCs is not paginated: a single response holds every VPC of the organization.
+	listResp, err := l.client.ListVPCs(ctx, connect.NewRequest(&networkingv1beta1.ListVPCsRequest{}))
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, &diagnostics)
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+
+	vpcs := slices.DeleteFunc(slices.Clone(listResp.Msg.Items), func(vpc *networkingv1beta1.VPC) bool {
+		return !coreweave.MatchesFilters(nameRegex, data.Zone, types.StringNull(), vpc.Name, vpc.Zone, "")
+	})
+	slices.SortFunc(vpcs, func(a, b *networkingv1beta1.VPC) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Zone, b.Zone), cmp.Compare(a.Id, b.Id))
+	})
+
+	stream.Results = coreweave.ListResults(ctx, req, vpcs, func(vpc *networkingv1beta1.VPC, result *list.ListResult) {
+		result.DisplayName = vpc.Name
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), vpc.Id)...)
+		if !req.IncludeResource {
+			return
+		}
+
+		state := vpcResourceStateModel{Timeouts: coreweave.NullTimeouts(ctx)}
+		result.Diagnostics.Append(state.Set(vpc)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+		result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+	})
+}
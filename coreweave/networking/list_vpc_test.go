@@ -0,0 +1,80 @@
+package networking_test
+
+import (
+	"testing"
+
+	networkingv1beta1 "buf.build/gen/go/coreweave/networking/protocolbuffers/go/coreweave/networking/v1beta1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave/networking"
+	"github.com/coreweave/terraform-provider-coreweave/internal/testutil"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVpcListResource_List(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.NewFakeClient(t)
+	ids := map[string]string{}
+	for _, vpc := range []*networkingv1beta1.CreateVPCRequest{
+		{Name: "shared", Zone: "US-EAST-04A", VpcPrefixes: []*networkingv1beta1.Prefix{{Name: "pod-cidr", Value: "10.0.0.0/16"}}},
+		{Name: "shared", Zone: "US-WEST-01A"},
+		{Name: "scratch", Zone: "US-EAST-04A"},
+	} {
+		created, err := client.CreateVPC(t.Context(), connect.NewRequest(vpc))
+		require.NoError(t, err)
+		ids[vpc.Name+"/"+vpc.Zone] = created.Msg.GetVpc().GetId()
+	}
+
+	tests := []struct {
+		name            string
+		config          map[string]any
+		includeResource bool
+		want            []string
+	}{
+		{
+			name: "all VPCs, sorted by name",
+			want: []string{"scratch/US-EAST-04A", "shared/US-EAST-04A", "shared/US-WEST-01A"},
+		},
+		{
+			name:            "by zone and name regex, with the resource",
+			config:          map[string]any{"zone": "US-EAST-04A", "name_regex": "^sh"},
+			includeResource: true,
+			want:            []string{"shared/US-EAST-04A"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			results := testutil.ListResource(t, networking.NewVpcListResource(), networking.NewVpcResource(), client, tt.config, tt.includeResource, 0)
+
+			vpcs := make([]string, len(results))
+			for i, result := range results {
+				require.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+
+				var id types.String
+				require.False(t, result.Identity.GetAttribute(t.Context(), path.Root("id"), &id).HasError())
+				for key, vpcID := range ids {
+					if vpcID == id.ValueString() {
+						vpcs[i] = key
+					}
+				}
+				assert.Equal(t, result.DisplayName+"/", vpcs[i][:len(result.DisplayName)+1])
+
+				if !tt.includeResource {
+					assert.True(t, result.Resource.Raw.IsNull())
+					continue
+				}
+				var prefixes []networking.VpcPrefixResourceModel
+				require.False(t, result.Resource.GetAttribute(t.Context(), path.Root("vpc_prefixes"), &prefixes).HasError())
+				require.Len(t, prefixes, 1)
+				assert.Equal(t, "10.0.0.0/16", prefixes[0].Value.ValueString())
+			}
+			assert.Equal(t, tt.want, vpcs)
+		})
+	}
+}
@@ -13,12 +13,14 @@ import (
 	"github.com/coreweave/terraform-provider-coreweave/coreweave"
 	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -30,6 +32,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/zclconf/go-cty/cty"
+	"google.golang.org/protobuf/proto"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -38,6 +41,14 @@ var (
 	_ resource.ResourceWithImportState      = &VpcResource{}
 	_ resource.ResourceWithConfigure        = &VpcResource{}
 	_ resource.ResourceWithConfigValidators = &VpcResource{}
+	_ resource.ResourceWithIdentity         = &VpcResource{}
+)
+
+const (
+	defaultVpcCreateTimeout = 20 * time.Minute
+	defaultVpcReadTimeout   = 5 * time.Minute
+	defaultVpcUpdateTimeout = 20 * time.Minute
+	defaultVpcDeleteTimeout = 20 * time.Minute
 )
 
 var hostPrefixObjectType = types.ObjectType{
@@ -56,6 +67,19 @@ var hostPrefixObjectType = types.ObjectType{
 	},
 }
 
+// vpcFieldPath maps API field paths onto the VPC schema, which mirrors the request except that the host prefixes,
+// the VPC prefixes and the DHCP DNS servers are sets, whose elements cannot be addressed by index.
+var vpcFieldPath = coreweave.FieldPathsWithin(map[string]bool{
+	"name":          true,
+	"zone":          true,
+	"host_prefix":   true,
+	"host_prefixes": false,
+	"vpc_prefixes":  false,
+	"ingress":       true,
+	"egress":        true,
+	"dhcp":          false,
+})
+
 func NewVpcResource() resource.Resource {
 	return &VpcResource{}
 }
@@ -264,6 +288,14 @@ type VpcResourceModel struct {
 	Dhcp         *VpcDhcpResourceModel    `tfsdk:"dhcp"`
 }
 
+// vpcResourceStateModel extends VpcResourceModel with the attributes that only exist on the resource,
+// so that VpcDataSourceModel can continue to share the VPC fields and their Set logic.
+type vpcResourceStateModel struct {
+	VpcResourceModel
+	AdoptExisting types.Bool     `tfsdk:"adopt_existing"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (v *VpcResourceModel) Set(vpc *networkingv1beta1.VPC) (diagnostics diag.Diagnostics) {
 	v.Id = types.StringValue(vpc.Id)
 	v.Name = types.StringValue(vpc.Name)
@@ -576,6 +608,21 @@ func (r *VpcResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 					},
 				},
 			},
+			"adopt_existing": coreweave.AdoptExistingAttribute("name and zone"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *VpcResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique identifier of the VPC.",
+			},
 		},
 	}
 }
@@ -600,32 +647,50 @@ func (r *VpcResource) Configure(_ context.Context, req resource.ConfigureRequest
 }
 
 func (r *VpcResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data VpcResourceModel
+	var data vpcResourceStateModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultVpcCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	createReq, diags := data.ToCreateRequest(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	var created *networkingv1beta1.VPC
 	createResp, err := r.client.CreateVPC(ctx, connect.NewRequest(createReq))
-	if err != nil {
-		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+	switch {
+	case err == nil:
+		created = createResp.Msg.Vpc
+	case data.AdoptExisting.ValueBool() && coreweave.IsAlreadyExistsError(err):
+		if created = r.findAdoptable(ctx, createReq, &resp.Diagnostics); created == nil {
+			return
+		}
+	default:
+		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics, coreweave.WithFieldPaths(vpcFieldPath))
 		return
 	}
 
 	// set state once vpc is created
-	data.Set(createResp.Msg.Vpc)
+	data.Set(created)
 	// if we fail to set state, return early as the resource will be orphaned
 	if diag := resp.State.Set(ctx, &data); diag.HasError() {
 		resp.Diagnostics.Append(diag...)
 		return
 	}
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("id"), data.Id)...)
 
 	// wait for the vpc to become ready
 	conf := retry.StateChangeConf{
@@ -633,24 +698,23 @@ func (r *VpcResource) Create(ctx context.Context, req resource.CreateRequest, re
 			networkingv1beta1.VPC_STATUS_CREATING.String(),
 			networkingv1beta1.VPC_STATUS_UNSPECIFIED.String(),
 		},
-		Target: []string{networkingv1beta1.VPC_STATUS_READY.String()},
-		Refresh: func() (result interface{}, state string, err error) {
-			resp, err := r.client.GetVPC(ctx, connect.NewRequest(&networkingv1beta1.GetVPCRequest{
-				Id: createResp.Msg.Vpc.Id,
-			}))
-			if err != nil {
-				tflog.Error(ctx, "failed to fetch vpc resource", map[string]interface{}{
-					"error": err,
-				})
-				return nil, networkingv1beta1.VPC_STATUS_UNSPECIFIED.String(), err
-			}
-
-			return resp.Msg.Vpc, resp.Msg.Vpc.Status.String(), nil
-		},
-		Timeout: 20 * time.Minute,
+		Target:  []string{networkingv1beta1.VPC_STATUS_READY.String()},
+		Timeout: createTimeout,
 	}
 
-	rawVpc, err := conf.WaitForStateContext(ctx)
+	rawVpc, err := coreweave.WaitForState(ctx, "coreweave_networking_vpc", "create", conf, func(ctx context.Context) (result interface{}, state string, err error) {
+		resp, err := r.client.GetVPC(ctx, connect.NewRequest(&networkingv1beta1.GetVPCRequest{
+			Id: created.Id,
+		}))
+		if err != nil {
+			tflog.Error(ctx, "failed to fetch vpc resource", map[string]interface{}{
+				"error": err,
+			})
+			return nil, networkingv1beta1.VPC_STATUS_UNSPECIFIED.String(), err
+		}
+
+		return resp.Msg.Vpc, resp.Msg.Vpc.Status.String(), nil
+	})
 	if err != nil {
 		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
 		return
@@ -672,14 +736,46 @@ func (r *VpcResource) Create(ctx context.Context, req resource.CreateRequest, re
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// findAdoptable returns the VPC with the name and zone of createReq, to adopt into state after creating it failed
+// because it already exists. It adds an error and returns nil if there is no such VPC, or it differs from createReq.
+func (r *VpcResource) findAdoptable(ctx context.Context, createReq *networkingv1beta1.CreateVPCRequest, diagnostics *diag.Diagnostics) *networkingv1beta1.VPC {
+	listResp, err := r.client.ListVPCs(ctx, connect.NewRequest(&networkingv1beta1.ListVPCsRequest{}))
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, diagnostics)
+		return nil
+	}
+
+	vpc, ok := coreweave.FindAdoptable(diagnostics, "VPC", createReq.Name, createReq, listResp.Msg.Items,
+		func(vpc *networkingv1beta1.VPC) bool {
+			return vpc.Name == createReq.Name && vpc.Zone == createReq.Zone
+		},
+		func(vpc *networkingv1beta1.VPC) proto.Message { return vpc },
+	)
+	if !ok {
+		return nil
+	}
+
+	tflog.Info(ctx, "adopting existing vpc", map[string]interface{}{"id": vpc.Id})
+	return vpc
+}
+
 func (r *VpcResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data VpcResourceModel
+	var data vpcResourceStateModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultVpcReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	vpc, err := r.client.GetVPC(ctx, connect.NewRequest(&networkingv1beta1.GetVPCRequest{
 		Id: data.Id.ValueString(),
 	}))
@@ -695,10 +791,11 @@ func (r *VpcResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 	data.Set(vpc.Msg.Vpc)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("id"), data.Id)...)
 }
 
 func (r *VpcResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data VpcResourceModel
+	var data vpcResourceStateModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -707,6 +804,15 @@ func (r *VpcResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultVpcUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	updateReq, diags := data.ToUpdateRequest(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -715,7 +821,7 @@ func (r *VpcResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 	updateResp, err := r.client.UpdateVPC(ctx, connect.NewRequest(updateReq))
 	if err != nil {
-		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics, coreweave.WithFieldPaths(vpcFieldPath))
 		return
 	}
 
@@ -725,28 +831,27 @@ func (r *VpcResource) Update(ctx context.Context, req resource.UpdateRequest, re
 			networkingv1beta1.VPC_STATUS_UPDATING.String(),
 			networkingv1beta1.VPC_STATUS_UNSPECIFIED.String(),
 		},
-		Target: []string{networkingv1beta1.VPC_STATUS_READY.String()},
-		Refresh: func() (result interface{}, state string, err error) {
-			resp, err := r.client.GetVPC(ctx, connect.NewRequest(&networkingv1beta1.GetVPCRequest{
-				Id: updateResp.Msg.Vpc.Id,
-			}))
-			if err != nil {
-				tflog.Error(ctx, "failed to fetch vpc resource", map[string]interface{}{
-					"error": err.Error(),
-				})
-				return nil, networkingv1beta1.VPC_STATUS_UNSPECIFIED.String(), err
-			}
+		Target:  []string{networkingv1beta1.VPC_STATUS_READY.String()},
+		Timeout: updateTimeout,
+	}
 
-			tflog.Info(ctx, "fetching vpc", map[string]interface{}{
-				"vpc": resp.Msg.Vpc.String(),
+	rawvpc, err := coreweave.WaitForState(ctx, "coreweave_networking_vpc", "update", conf, func(ctx context.Context) (result interface{}, state string, err error) {
+		resp, err := r.client.GetVPC(ctx, connect.NewRequest(&networkingv1beta1.GetVPCRequest{
+			Id: updateResp.Msg.Vpc.Id,
+		}))
+		if err != nil {
+			tflog.Error(ctx, "failed to fetch vpc resource", map[string]interface{}{
+				"error": err.Error(),
 			})
+			return nil, networkingv1beta1.VPC_STATUS_UNSPECIFIED.String(), err
+		}
 
-			return resp.Msg.Vpc, resp.Msg.Vpc.Status.String(), nil
-		},
-		Timeout: 20 * time.Minute,
-	}
+		tflog.Info(ctx, "fetching vpc", map[string]interface{}{
+			"vpc": resp.Msg.Vpc.String(),
+		})
 
-	rawvpc, err := conf.WaitForStateContext(ctx)
+		return resp.Msg.Vpc, resp.Msg.Vpc.Status.String(), nil
+	})
 	if err != nil {
 		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
 		return
@@ -763,16 +868,26 @@ func (r *VpcResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 	data.Set(vpc)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("id"), data.Id)...)
 }
 
 func (r *VpcResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data VpcResourceModel
+	var data vpcResourceStateModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultVpcDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	deleteResp, err := r.client.DeleteVPC(ctx, connect.NewRequest(&networkingv1beta1.DeleteVPCRequest{
 		Id: data.Id.ValueString(),
 	}))
@@ -789,29 +904,28 @@ func (r *VpcResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 			networkingv1beta1.VPC_STATUS_DELETING.String(),
 			networkingv1beta1.VPC_STATUS_UNSPECIFIED.String(),
 		},
-		Target: []string{""},
-		Refresh: func() (result interface{}, state string, err error) {
-			resp, err := r.client.GetVPC(ctx, connect.NewRequest(&networkingv1beta1.GetVPCRequest{
-				Id: deleteResp.Msg.Vpc.Id,
-			}))
-			if err != nil {
-				var connectErr *connect.Error
-				if errors.As(err, &connectErr) && connectErr.Code() == connect.CodeNotFound {
-					return struct{}{}, "", nil
-				}
+		Target:  []string{""},
+		Timeout: deleteTimeout,
+	}
 
-				tflog.Error(ctx, "failed to fetch vpc", map[string]interface{}{
-					"error": err.Error(),
-				})
-				return nil, networkingv1beta1.VPC_STATUS_UNSPECIFIED.String(), err
+	_, err = coreweave.WaitForState(ctx, "coreweave_networking_vpc", "delete", conf, func(ctx context.Context) (result interface{}, state string, err error) {
+		resp, err := r.client.GetVPC(ctx, connect.NewRequest(&networkingv1beta1.GetVPCRequest{
+			Id: deleteResp.Msg.Vpc.Id,
+		}))
+		if err != nil {
+			var connectErr *connect.Error
+			if errors.As(err, &connectErr) && connectErr.Code() == connect.CodeNotFound {
+				return struct{}{}, "", nil
 			}
 
-			return resp.Msg.Vpc, resp.Msg.Vpc.Status.String(), nil
-		},
-		Timeout: 20 * time.Minute,
-	}
+			tflog.Error(ctx, "failed to fetch vpc", map[string]interface{}{
+				"error": err.Error(),
+			})
+			return nil, networkingv1beta1.VPC_STATUS_UNSPECIFIED.String(), err
+		}
 
-	_, err = conf.WaitForStateContext(ctx)
+		return resp.Msg.Vpc, resp.Msg.Vpc.Status.String(), nil
+	})
 	if err != nil {
 		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
 		return
@@ -819,7 +933,7 @@ func (r *VpcResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 func (r *VpcResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // hostPrefixToCtyValue converts a HostPrefixResourceModel to a cty.Value for HCL rendering.
@@ -0,0 +1,58 @@
+package networking
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	networkingv1beta1 "buf.build/gen/go/coreweave/networking/protocolbuffers/go/coreweave/networking/v1beta1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave/fake"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVpcResource_FindAdoptable(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(fake.New(fake.WithDelay(0)).Handler())
+	t.Cleanup(server.Close)
+
+	token := connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			req.Header().Set("Authorization", "Bearer fake")
+			return next(ctx, req)
+		}
+	})
+	client, err := coreweave.NewClient(server.URL, server.URL, 10*time.Second, coreweave.RetryConfig{}, coreweave.TransportConfig{}, token)
+	require.NoError(t, err)
+	r := &VpcResource{client: client}
+
+	createReq := &networkingv1beta1.CreateVPCRequest{
+		Name:        "interrupted",
+		Zone:        "US-EAST-04A",
+		VpcPrefixes: []*networkingv1beta1.Prefix{{Name: "pod-cidr", Value: "10.0.0.0/16"}},
+	}
+	created, err := client.CreateVPC(t.Context(), connect.NewRequest(createReq))
+	require.NoError(t, err)
+
+	_, err = client.CreateVPC(t.Context(), connect.NewRequest(createReq))
+	require.True(t, coreweave.IsAlreadyExistsError(err))
+
+	var diagnostics diag.Diagnostics
+	adopted := r.findAdoptable(t.Context(), createReq, &diagnostics)
+	require.Empty(t, diagnostics)
+	assert.Equal(t, created.Msg.GetVpc().GetId(), adopted.GetId())
+
+	changed := &networkingv1beta1.CreateVPCRequest{
+		Name:        "interrupted",
+		Zone:        "US-EAST-04A",
+		VpcPrefixes: []*networkingv1beta1.Prefix{{Name: "pod-cidr", Value: "10.1.0.0/16"}},
+	}
+	assert.Nil(t, r.findAdoptable(t.Context(), changed, &diagnostics))
+	require.Len(t, diagnostics, 1)
+	assert.Contains(t, diagnostics[0].Detail(), "differs from the configuration in: vpc_prefixes")
+}
@@ -0,0 +1,237 @@
+package objectstorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	cwobjectv1 "buf.build/gen/go/coreweave/cwobject/protocolbuffers/go/cwobject/v1"
+	"connectrpc.com/connect"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/transport/http"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &BucketDataSource{}
+)
+
+const (
+	// bucketVersioningDisabled is the versioning status of a bucket on which versioning has never been enabled, for
+	// which S3 returns no status at all.
+	bucketVersioningDisabled string = "Disabled"
+)
+
+func NewBucketDataSource() datasource.DataSource {
+	return &BucketDataSource{}
+}
+
+// BucketDataSource reads a bucket without managing it.
+type BucketDataSource struct {
+	client *coreweave.Client
+}
+
+type BucketDataSourceModel struct {
+	Name                       types.String `tfsdk:"name"`
+	Zone                       types.String `tfsdk:"zone"`
+	Tags                       types.Map    `tfsdk:"tags"`
+	VersioningStatus           types.String `tfsdk:"versioning_status"`
+	AuditLoggingEnabled        types.Bool   `tfsdk:"audit_logging_enabled"`
+	ArchiveEnabled             types.Bool   `tfsdk:"archive_enabled"`
+	ArchiveAfterLastAccessDays types.Int32  `tfsdk:"archive_after_last_access_days"`
+	VirtualHostEndpoint        types.String `tfsdk:"virtual_host_endpoint"`
+	PathStyleEndpoint          types.String `tfsdk:"path_style_endpoint"`
+}
+
+func (d *BucketDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_storage_bucket"
+}
+
+func (d *BucketDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := bucketDataSourceAttributes()
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the bucket.",
+		Required:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Query information about an existing CoreWeave AI Object Storage bucket, such as one managed by another Terraform configuration, without taking ownership of it. Learn more about [buckets](https://docs.coreweave.com/products/storage/object-storage/buckets/create-bucket).",
+		Attributes:          attributes,
+	}
+}
+
+// bucketDataSourceAttributes returns the attributes of a bucket other than name, which is required by the data source
+// of a single bucket but computed for the items of the data source that lists them.
+func bucketDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"zone": schema.StringAttribute{
+			MarkdownDescription: "The Availability Zone in which the bucket is located.",
+			Computed:            true,
+		},
+		"tags": schema.MapAttribute{
+			MarkdownDescription: "The tags assigned to the bucket, excluding tags ignored by the provider `ignore_tags` block.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"versioning_status": schema.StringAttribute{
+			MarkdownDescription: "The versioning state of the bucket: `Enabled`, `Suspended`, or `Disabled` if versioning has never been enabled.",
+			Computed:            true,
+		},
+		"audit_logging_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether audit logging is enabled for the bucket.",
+			Computed:            true,
+		},
+		"archive_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether idle STANDARD objects are archived to STANDARD_IA after `archive_after_last_access_days` without access.",
+			Computed:            true,
+		},
+		"archive_after_last_access_days": schema.Int32Attribute{
+			MarkdownDescription: "Days since last access before a STANDARD object version is archived to STANDARD_IA, if archive is enabled.",
+			Computed:            true,
+		},
+		"virtual_host_endpoint": schema.StringAttribute{
+			MarkdownDescription: "The URL of the bucket with virtual-hosted-style addressing, in which the bucket is a subdomain of the S3 endpoint.",
+			Computed:            true,
+		},
+		"path_style_endpoint": schema.StringAttribute{
+			MarkdownDescription: "The URL of the bucket with path-style addressing, in which the bucket is the first segment of the path of the S3 endpoint.",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *BucketDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*coreweave.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *coreweave.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BucketDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	data := new(BucketDataSourceModel)
+	resp.Diagnostics.Append(req.Config.Get(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getResp, err := d.client.GetBucketInfo(ctx, connect.NewRequest(&cwobjectv1.GetBucketInfoRequest{
+		BucketName: data.Name.ValueString(),
+	}))
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+		return
+	}
+
+	if !readBucket(ctx, d.client, getResp.Msg.Info, data, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError("Bucket Not Found", fmt.Sprintf("Bucket '%s' does not exist.", data.Name.ValueString()))
+		}
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// readBucket fills in data with the bucket described by info, reading what the CWObject API does not return from S3.
+// It returns false if the bucket no longer exists, and adds an error and returns false if it cannot be read.
+func readBucket(ctx context.Context, client *coreweave.Client, info *cwobjectv1.BucketInfo, data *BucketDataSourceModel, diagnostics *diag.Diagnostics) bool {
+	name := info.GetName()
+	s3Client, err := client.S3Client(ctx, info.GetLocation())
+	if err != nil {
+		diagnostics.AddError("Failed to create S3 client", err.Error())
+		return false
+	}
+
+	head, err := s3Client.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: aws.String(name)})
+	if err != nil {
+		var httpErr *http.ResponseError
+		if errors.As(err, &httpErr) && httpErr.Response != nil && httpErr.Response.StatusCode == 404 {
+			return false
+		}
+		handleS3Error(err, diagnostics, name)
+		return false
+	}
+
+	zone := info.GetLocation()
+	if zone == "" {
+		zone = aws.ToString(head.BucketRegion)
+	}
+
+	tags := map[string]string{}
+	tagging, err := s3Client.GetBucketTagging(ctx, &s3.GetBucketTaggingInput{Bucket: aws.String(name)})
+	var apiErr smithy.APIError
+	switch {
+	case errors.As(err, &apiErr) && apiErr.ErrorCode() == errNoSuchTagSet:
+		// the bucket has no tags
+	case err != nil:
+		handleS3Error(err, diagnostics, name)
+		return false
+	default:
+		tags = client.Tags.WithoutIgnored(tagSetToMap(tagging.TagSet))
+	}
+
+	versioning, err := s3Client.GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{Bucket: aws.String(name)})
+	if err != nil {
+		handleS3Error(err, diagnostics, name)
+		return false
+	}
+	versioningStatus := string(versioning.Status)
+	if versioningStatus == "" {
+		versioningStatus = bucketVersioningDisabled
+	}
+
+	virtualHost, pathStyle, err := bucketEndpoints(client.S3Endpoint(), name)
+	if err != nil {
+		diagnostics.AddError("Invalid S3 Endpoint", err.Error())
+		return false
+	}
+
+	var settings BucketSettingsModel
+	settings.Set(info.GetSettings())
+
+	var diags diag.Diagnostics
+	data.Name = types.StringValue(name)
+	data.Zone = types.StringValue(zone)
+	data.Tags, diags = tagsValue(tags)
+	diagnostics.Append(diags...)
+	data.VersioningStatus = types.StringValue(versioningStatus)
+	data.AuditLoggingEnabled = settings.AuditLoggingEnabled
+	data.ArchiveEnabled = settings.ArchiveEnabled
+	data.ArchiveAfterLastAccessDays = settings.ArchiveAfterLastAccessDays
+	data.VirtualHostEndpoint = types.StringValue(virtualHost)
+	data.PathStyleEndpoint = types.StringValue(pathStyle)
+	return !diagnostics.HasError()
+}
+
+// bucketEndpoints returns the virtual-hosted-style and path-style URLs of bucket on the S3 endpoint.
+func bucketEndpoints(endpoint, bucket string) (virtualHost, pathStyle string, err error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", "", err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return "", "", fmt.Errorf("S3 endpoint %q is not an absolute URL", endpoint)
+	}
+
+	hosted := *u
+	hosted.Host = bucket + "." + u.Host
+	return hosted.String(), u.JoinPath(bucket).String(), nil
+}
@@ -0,0 +1,171 @@
+package objectstorage_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	cwobjectv1 "buf.build/gen/go/coreweave/cwobject/protocolbuffers/go/cwobject/v1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	objectstorage "github.com/coreweave/terraform-provider-coreweave/coreweave/object_storage"
+	"github.com/coreweave/terraform-provider-coreweave/internal/testutil"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// fakeS3Bucket is a bucket served by newFakeS3.
+type fakeS3Bucket struct {
+	zone       string
+	tags       map[string]string
+	versioning string
+}
+
+// newFakeS3 serves the path-style S3 calls made to read buckets, for the given buckets, and returns its endpoint. Any
+// other bucket does not exist.
+func newFakeS3(t *testing.T, buckets map[string]fakeS3Bucket) string {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bucket, ok := buckets[strings.Trim(r.URL.Path, "/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		query := r.URL.Query()
+		switch {
+		case r.Method == http.MethodHead:
+			w.Header().Set("X-Amz-Bucket-Region", bucket.zone)
+		case query.Has("location"):
+			fmt.Fprintf(w, "<LocationConstraint>%s</LocationConstraint>", bucket.zone)
+		case query.Has("tagging") && len(bucket.tags) == 0:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `<Error><Code>NoSuchTagSet</Code><Message>The TagSet does not exist</Message></Error>`)
+		case query.Has("tagging"):
+			var tags strings.Builder
+			for key, value := range bucket.tags {
+				fmt.Fprintf(&tags, "<Tag><Key>%s</Key><Value>%s</Value></Tag>", key, value)
+			}
+			fmt.Fprintf(w, "<Tagging><TagSet>%s</TagSet></Tagging>", tags.String())
+		case query.Has("versioning") && bucket.versioning == "":
+			fmt.Fprint(w, "<VersioningConfiguration></VersioningConfiguration>")
+		case query.Has("versioning"):
+			fmt.Fprintf(w, "<VersioningConfiguration><Status>%s</Status></VersioningConfiguration>", bucket.versioning)
+		default:
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+// newBucketDataSourceClient returns a client of the fake API and a fake S3 API with the given buckets, and creates
+// every bucket of cwobjectBuckets, which may differ from them, in the fake API.
+func newBucketDataSourceClient(t *testing.T, buckets map[string]fakeS3Bucket, cwobjectBuckets ...string) (*coreweave.Client, string) {
+	t.Helper()
+
+	s3Endpoint := newFakeS3(t, buckets)
+	client := testutil.NewFakeClientWithS3(t, s3Endpoint)
+	for _, name := range cwobjectBuckets {
+		_, err := client.SetBucketSettings(t.Context(), connect.NewRequest(&cwobjectv1.SetBucketSettingsRequest{
+			BucketName: name,
+			Settings:   &cwobjectv1.CWObjectBucketSettings{AuditLoggingEnabled: wrapperspb.Bool(name == "models")},
+		}))
+		require.NoError(t, err)
+	}
+	return client, s3Endpoint
+}
+
+func TestBucketDataSource_Read(t *testing.T) {
+	t.Parallel()
+
+	client, s3Endpoint := newBucketDataSourceClient(t, map[string]fakeS3Bucket{
+		"models": {zone: "US-EAST-04A", tags: map[string]string{"team": "ml"}, versioning: "Enabled"},
+	}, "models", "deleted")
+
+	t.Run("existing bucket", func(t *testing.T) {
+		t.Parallel()
+
+		state, diagnostics := testutil.ReadDataSource(t, objectstorage.NewBucketDataSource(), client, map[string]any{"name": "models"})
+		require.False(t, diagnostics.HasError(), diagnostics)
+
+		var data objectstorage.BucketDataSourceModel
+		require.False(t, state.Get(t.Context(), &data).HasError())
+
+		assert.Equal(t, "US-EAST-04A", data.Zone.ValueString())
+		assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("ml")}), data.Tags)
+		assert.Equal(t, "Enabled", data.VersioningStatus.ValueString())
+		assert.True(t, data.AuditLoggingEnabled.ValueBool())
+		assert.True(t, data.ArchiveEnabled.IsNull())
+		assert.Equal(t, strings.Replace(s3Endpoint, "://", "://models.", 1), data.VirtualHostEndpoint.ValueString())
+		assert.Equal(t, s3Endpoint+"/models", data.PathStyleEndpoint.ValueString())
+	})
+
+	for name, bucket := range map[string]string{
+		"bucket unknown to the API": "missing",
+		"bucket missing from S3":    "deleted",
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, diagnostics := testutil.ReadDataSource(t, objectstorage.NewBucketDataSource(), client, map[string]any{"name": bucket})
+			require.True(t, diagnostics.HasError())
+		})
+	}
+}
+
+func TestBucketsDataSource_Read(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newBucketDataSourceClient(t, map[string]fakeS3Bucket{
+		"models":   {zone: "US-EAST-04A", versioning: "Suspended"},
+		"datasets": {zone: "US-WEST-01A"},
+		"metrics":  {zone: "US-EAST-04A"},
+	}, "models", "datasets", "metrics", "deleted")
+
+	tests := []struct {
+		name   string
+		config map[string]any
+		want   []string
+	}{
+		{
+			name: "all buckets, sorted by name, without deleted ones",
+			want: []string{"datasets/US-WEST-01A/Disabled", "metrics/US-EAST-04A/Disabled", "models/US-EAST-04A/Suspended"},
+		},
+		{
+			name:   "by zone",
+			config: map[string]any{"zone": "US-EAST-04A"},
+			want:   []string{"metrics/US-EAST-04A/Disabled", "models/US-EAST-04A/Suspended"},
+		},
+		{
+			name:   "by name regex",
+			config: map[string]any{"name_regex": "^(d|mo)"},
+			want:   []string{"datasets/US-WEST-01A/Disabled", "models/US-EAST-04A/Suspended"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state, diagnostics := testutil.ReadDataSource(t, objectstorage.NewBucketsDataSource(), client, tt.config)
+			require.False(t, diagnostics.HasError(), diagnostics)
+
+			var data objectstorage.BucketsDataSourceModel
+			require.False(t, state.Get(t.Context(), &data).HasError())
+
+			buckets := make([]string, len(data.Buckets))
+			for i, bucket := range data.Buckets {
+				buckets[i] = bucket.Name.ValueString() + "/" + bucket.Zone.ValueString() + "/" + bucket.VersioningStatus.ValueString()
+				assert.True(t, bucket.Tags.IsNull())
+			}
+			assert.Equal(t, tt.want, buckets)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package objectstorage
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	cwobjectv1 "buf.build/gen/go/coreweave/cwobject/protocolbuffers/go/cwobject/v1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &BucketsDataSource{}
+)
+
+func NewBucketsDataSource() datasource.DataSource {
+	return &BucketsDataSource{}
+}
+
+type BucketsDataSource struct {
+	client *coreweave.Client
+}
+
+type BucketsDataSourceModel struct {
+	Zone      types.String            `tfsdk:"zone"`
+	NameRegex types.String            `tfsdk:"name_regex"`
+	Buckets   []BucketDataSourceModel `tfsdk:"buckets"`
+}
+
+func (d *BucketsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_storage_buckets"
+}
+
+func (d *BucketsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	bucketAttributes := bucketDataSourceAttributes()
+	bucketAttributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the bucket.",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the existing CoreWeave AI Object Storage buckets, optionally filtered by zone and name, without taking ownership of them. Learn more about [buckets](https://docs.coreweave.com/products/storage/object-storage/buckets/create-bucket).",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "Only list the buckets in this Availability Zone.",
+				Optional:            true,
+			},
+			"name_regex": coreweave.NameRegexAttribute("buckets"),
+			"buckets": schema.ListNestedAttribute{
+				MarkdownDescription: "The buckets that match the filters, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: bucketAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *BucketsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*coreweave.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *coreweave.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BucketsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	data := new(BucketsDataSourceModel)
+	resp.Diagnostics.Append(req.Config.Get(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegex := coreweave.CompileNameRegex(data.NameRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ListBucketInfo is not paginated: a single response holds every bucket of the organization.
+	listResp, err := d.client.ListBucketInfo(ctx, connect.NewRequest(&cwobjectv1.ListBucketInfoRequest{}))
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+		return
+	}
+
+	infos := slices.DeleteFunc(slices.Clone(listResp.Msg.Info), func(info *cwobjectv1.BucketInfo) bool {
+		// a bucket without a location has its zone read from S3, so it can only be filtered by zone once it is read
+		zone := data.Zone
+		if info.GetLocation() == "" {
+			zone = types.StringNull()
+		}
+		return !coreweave.MatchesFilters(nameRegex, zone, types.StringNull(), info.GetName(), info.GetLocation(), "")
+	})
+	slices.SortFunc(infos, func(a, b *cwobjectv1.BucketInfo) int {
+		return cmp.Compare(a.GetName(), b.GetName())
+	})
+
+	data.Buckets = make([]BucketDataSourceModel, 0, len(infos))
+	for _, info := range infos {
+		var bucket BucketDataSourceModel
+		// a bucket deleted since it was listed is left out
+		if !readBucket(ctx, d.client, info, &bucket, &resp.Diagnostics) {
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			continue
+		}
+		if coreweave.MatchesFilters(nil, data.Zone, types.StringNull(), bucket.Name.ValueString(), bucket.Zone.ValueString(), "") {
+			data.Buckets = append(data.Buckets, bucket)
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
@@ -0,0 +1,153 @@
+package objectstorage
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	cwobjectv1 "buf.build/gen/go/coreweave/cwobject/protocolbuffers/go/cwobject/v1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &OrganizationAccessPoliciesDataSource{}
+)
+
+func NewOrganizationAccessPoliciesDataSource() datasource.DataSource {
+	return &OrganizationAccessPoliciesDataSource{}
+}
+
+type OrganizationAccessPoliciesDataSource struct {
+	client *coreweave.Client
+}
+
+type OrganizationAccessPoliciesDataSourceModel struct {
+	NameRegex types.String                            `tfsdk:"name_regex"`
+	Principal types.String                            `tfsdk:"principal"`
+	Policies  []OrganizationAccessPolicyResourceModel `tfsdk:"policies"`
+}
+
+func (d *OrganizationAccessPoliciesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_storage_organization_access_policies"
+}
+
+func (d *OrganizationAccessPoliciesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the existing [organization access policies](https://docs.coreweave.com/products/storage/object-storage/auth-access/organization-policies/about) of the CoreWeave AI Object Storage organization, and their statements, optionally filtered by name and principal.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": coreweave.NameRegexAttribute("organization access policies"),
+			"principal": schema.StringAttribute{
+				MarkdownDescription: "Only list the policies with at least one statement that applies to this principal, e.g. `*` or `coreweave/UserUID`. Principals are compared as written in the policies: wildcards are not expanded.",
+				Optional:            true,
+			},
+			"policies": schema.ListNestedAttribute{
+				MarkdownDescription: "The organization access policies that match the filters, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the organization access policy.",
+							Computed:            true,
+						},
+						"statements": schema.ListNestedAttribute{
+							MarkdownDescription: "The statements of the policy, in the order the API returns them.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										MarkdownDescription: "The identifier of the statement.",
+										Computed:            true,
+									},
+									"effect": schema.StringAttribute{
+										MarkdownDescription: "Whether the statement allows or denies the actions, either `Allow` or `Deny`.",
+										Computed:            true,
+									},
+									"actions": schema.SetAttribute{
+										MarkdownDescription: "The S3 (`s3:*`) and AI Object Storage API (`cwobject:*`) actions the statement covers.",
+										ElementType:         types.StringType,
+										Computed:            true,
+									},
+									"resources": schema.SetAttribute{
+										MarkdownDescription: "The resources the statement covers.",
+										ElementType:         types.StringType,
+										Computed:            true,
+									},
+									"principals": schema.SetAttribute{
+										MarkdownDescription: "The users, roles or groups the statement applies to.",
+										ElementType:         types.StringType,
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OrganizationAccessPoliciesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*coreweave.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *coreweave.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OrganizationAccessPoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	data := new(OrganizationAccessPoliciesDataSourceModel)
+	resp.Diagnostics.Append(req.Config.Get(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegex := coreweave.CompileNameRegex(data.NameRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ListAccessPolicies is not paginated: a single response holds every policy of the organization.
+	listResp, err := d.client.ListAccessPolicies(ctx, connect.NewRequest(&cwobjectv1.ListAccessPoliciesRequest{}))
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+		return
+	}
+
+	policies := slices.DeleteFunc(slices.Clone(listResp.Msg.Policies), func(policy *cwobjectv1.CWObjectPolicy) bool {
+		return !coreweave.MatchesFilters(nameRegex, types.StringNull(), types.StringNull(), policy.GetName(), "", "") ||
+			(!data.Principal.IsNull() && !hasPrincipal(policy, data.Principal.ValueString()))
+	})
+	slices.SortFunc(policies, func(a, b *cwobjectv1.CWObjectPolicy) int {
+		return cmp.Compare(a.GetName(), b.GetName())
+	})
+
+	data.Policies = make([]OrganizationAccessPolicyResourceModel, len(policies))
+	for i, policy := range policies {
+		data.Policies[i].Set(policy)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// hasPrincipal reports whether any statement of policy applies to principal.
+func hasPrincipal(policy *cwobjectv1.CWObjectPolicy, principal string) bool {
+	return slices.ContainsFunc(policy.GetStatements(), func(statement *cwobjectv1.CWObjectPolicyStatement) bool {
+		return slices.Contains(statement.GetPrincipals(), principal)
+	})
+}
@@ -0,0 +1,92 @@
+package objectstorage_test
+
+import (
+	"testing"
+
+	cwobjectv1 "buf.build/gen/go/coreweave/cwobject/protocolbuffers/go/cwobject/v1"
+	"connectrpc.com/connect"
+	objectstorage "github.com/coreweave/terraform-provider-coreweave/coreweave/object_storage"
+	"github.com/coreweave/terraform-provider-coreweave/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationAccessPoliciesDataSource_Read(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.NewFakeClient(t)
+	for _, policy := range []*cwobjectv1.CWObjectPolicy{
+		{
+			Name: "readers",
+			Statements: []*cwobjectv1.CWObjectPolicyStatement{{
+				Name:       "read",
+				Effect:     "Allow",
+				Actions:    []string{"s3:GetObject", "s3:ListBucket"},
+				Resources:  []string{"models/*"},
+				Principals: []string{"coreweave/reader"},
+			}},
+		},
+		{
+			Name: "admins",
+			Statements: []*cwobjectv1.CWObjectPolicyStatement{
+				{Name: "all", Effect: "Allow", Actions: []string{"s3:*"}, Resources: []string{"*"}, Principals: []string{"coreweave/admin"}},
+				{Name: "public", Effect: "Allow", Actions: []string{"s3:*"}, Resources: []string{"*"}, Principals: []string{"*"}},
+			},
+		},
+	} {
+		_, err := client.EnsureAccessPolicy(t.Context(), connect.NewRequest(&cwobjectv1.EnsureAccessPolicyRequest{Policy: policy}))
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name   string
+		config map[string]any
+		want   []string
+	}{
+		{name: "all policies, sorted by name", want: []string{"admins", "readers"}},
+		{name: "by name regex", config: map[string]any{"name_regex": "^read"}, want: []string{"readers"}},
+		{name: "by principal", config: map[string]any{"principal": "*"}, want: []string{"admins"}},
+		{name: "no match", config: map[string]any{"principal": "coreweave/missing"}, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state, diagnostics := testutil.ReadDataSource(t, objectstorage.NewOrganizationAccessPoliciesDataSource(), client, tt.config)
+			require.False(t, diagnostics.HasError(), diagnostics)
+
+			var data objectstorage.OrganizationAccessPoliciesDataSourceModel
+			require.False(t, state.Get(t.Context(), &data).HasError())
+
+			names := make([]string, len(data.Policies))
+			for i, policy := range data.Policies {
+				names[i] = policy.Name.ValueString()
+				assert.NotEmpty(t, policy.Statements)
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+
+	t.Run("statements", func(t *testing.T) {
+		t.Parallel()
+
+		state, diagnostics := testutil.ReadDataSource(t, objectstorage.NewOrganizationAccessPoliciesDataSource(), client, map[string]any{"name_regex": "^admins$"})
+		require.False(t, diagnostics.HasError(), diagnostics)
+
+		var data objectstorage.OrganizationAccessPoliciesDataSourceModel
+		require.False(t, state.Get(t.Context(), &data).HasError())
+		require.Len(t, data.Policies, 1)
+		require.Len(t, data.Policies[0].Statements, 2)
+
+		public := data.Policies[0].Statements[1]
+		assert.Equal(t, "public", public.Name.ValueString())
+		assert.Equal(t, "Allow", public.Effect.ValueString())
+
+		var actions, principals []string
+		require.False(t, public.Actions.ElementsAs(t.Context(), &actions, false).HasError())
+		require.False(t, public.Principals.ElementsAs(t.Context(), &principals, false).HasError())
+		assert.Equal(t, []string{"s3:*"}, actions)
+		assert.Equal(t, []string{"*"}, principals)
+	})
+}
@@ -0,0 +1,147 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = &AccessKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &AccessKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &AccessKeyEphemeralResource{}
+)
+
+func NewAccessKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &AccessKeyEphemeralResource{}
+}
+
+// AccessKeyEphemeralResource mints short-lived Object Storage access keys. Its values are never persisted to state.
+type AccessKeyEphemeralResource struct {
+	client *coreweave.Client
+}
+
+type AccessKeyEphemeralResourceModel struct {
+	Duration        types.String `tfsdk:"duration"`
+	AccessKeyID     types.String `tfsdk:"access_key_id"`
+	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+	ExpiresAt       types.String `tfsdk:"expires_at"`
+	Endpoint        types.String `tfsdk:"endpoint"`
+}
+
+func (e *AccessKeyEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_storage_access_key"
+}
+
+func (e *AccessKeyEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Mints a short-lived CoreWeave AI Object Storage access key for the identity of the provider's API token. The key is never persisted to state or plan, so it can be passed to other providers, such as the `aws` provider configured with the CoreWeave S3 endpoint, for the duration of a Terraform operation. Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"duration": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "How long the access key is valid for, as a duration such as `1h` or `90m`. It should cover the whole Terraform operation that uses the key. Defaults to `15m`.",
+			},
+			"access_key_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The access key ID.",
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The secret access key.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The time the access key expires, in RFC 3339 format.",
+			},
+			"endpoint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Object Storage S3 endpoint the provider is configured with.",
+			},
+		},
+	}
+}
+
+func (e *AccessKeyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*coreweave.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *coreweave.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	e.client = client
+}
+
+func (e *AccessKeyEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var data AccessKeyEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := accessKeyDuration(data.Duration); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("duration"), "Invalid Duration", err.Error())
+	}
+}
+
+// accessKeyDuration parses the duration attribute, which defaults to coreweave.DefaultAccessKeyDuration.
+func accessKeyDuration(value types.String) (time.Duration, error) {
+	if value.IsNull() || value.IsUnknown() {
+		return coreweave.DefaultAccessKeyDuration, nil
+	}
+
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		return 0, fmt.Errorf("expected a duration such as \"1h\" or \"90m\", got %q", value.ValueString())
+	}
+	if duration < time.Second {
+		return 0, fmt.Errorf("duration must be at least 1s, got %q", value.ValueString())
+	}
+
+	return duration, nil
+}
+
+func (e *AccessKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data AccessKeyEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	duration, err := accessKeyDuration(data.Duration)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("duration"), "Invalid Duration", err.Error())
+		return
+	}
+
+	key, err := e.client.CreateAccessKey(ctx, duration)
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
+		return
+	}
+
+	data.AccessKeyID = types.StringValue(key.AccessKeyId)
+	data.SecretAccessKey = types.StringValue(key.SecretKey)
+	data.ExpiresAt = types.StringNull()
+	if key.Expiry != nil {
+		data.ExpiresAt = types.StringValue(key.Expiry.AsTime().UTC().Format(time.RFC3339))
+	}
+	data.Endpoint = types.StringValue(e.client.S3Endpoint())
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
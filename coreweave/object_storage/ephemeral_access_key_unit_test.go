@@ -0,0 +1,167 @@
+package objectstorage_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"buf.build/gen/go/coreweave/cwobject/connectrpc/go/cwobject/v1/cwobjectv1connect"
+	cwobjectv1 "buf.build/gen/go/coreweave/cwobject/protocolbuffers/go/cwobject/v1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/internal/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const accessKeyTypeName = "coreweave_object_storage_access_key"
+
+// fakeAccessKeyService mints access keys, and serves an S3 ListBuckets
+// response so that minted keys are immediately usable.
+type fakeAccessKeyService struct {
+	cwobjectv1connect.UnimplementedCWObjectHandler
+
+	mu        sync.Mutex
+	durations []uint32
+	expiry    time.Time
+}
+
+func (f *fakeAccessKeyService) CreateAccessKeyFromJWT(
+	_ context.Context,
+	req *connect.Request[cwobjectv1.CreateAccessKeyFromJWTRequest],
+) (*connect.Response[cwobjectv1.CreateAccessKeyFromJWTResponse], error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.durations = append(f.durations, req.Msg.GetDurationSeconds().GetValue())
+
+	return connect.NewResponse(&cwobjectv1.CreateAccessKeyFromJWTResponse{
+		AccessKeyId: "CWTESTACCESSKEY",
+		SecretKey:   "test-secret-key",
+		Expiry:      timestamppb.New(f.expiry),
+	}), nil
+}
+
+func newAccessKeyProviderServer(ctx context.Context, t *testing.T) (tfprotov6.ProviderServer, *fakeAccessKeyService, string) {
+	t.Helper()
+
+	fake := &fakeAccessKeyService{expiry: time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)}
+
+	mux := http.NewServeMux()
+	mux.Handle(cwobjectv1connect.NewCWObjectHandler(fake))
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = w.Write([]byte(`<ListAllMyBucketsResult><Buckets></Buckets></ListAllMyBucketsResult>`))
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	t.Setenv("COREWEAVE_API_ENDPOINT", srv.URL)
+	t.Setenv("COREWEAVE_S3_ENDPOINT", srv.URL)
+	t.Setenv("COREWEAVE_API_TOKEN", "fake-token")
+
+	server, err := provider.TestProtoV6ProviderFactories["coreweave"]()
+	require.NoError(t, err)
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Empty(t, schemaResp.Diagnostics)
+
+	providerConfig, err := tfprotov6.NewDynamicValue(
+		schemaResp.Provider.ValueType(), nullAttributesOf(t, schemaResp.Provider.ValueType()))
+	require.NoError(t, err)
+
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: &providerConfig})
+	require.NoError(t, err)
+	requireNoDiagErrors(t, configureResp.Diagnostics, "configure provider")
+
+	return server, fake, srv.URL
+}
+
+func accessKeyConfig(t *testing.T, objectType tftypes.Type, duration *string) *tfprotov6.DynamicValue {
+	t.Helper()
+
+	config := nullAttributesOf(t, objectType)
+	var attrs map[string]tftypes.Value
+	require.NoError(t, config.As(&attrs))
+	if duration != nil {
+		attrs["duration"] = tftypes.NewValue(tftypes.String, *duration)
+	}
+
+	dv, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, attrs))
+	require.NoError(t, err)
+	return &dv
+}
+
+func TestAccessKeyEphemeralResource(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		duration     *string
+		wantDuration uint32
+	}{
+		"default duration": {
+			wantDuration: 900,
+		},
+		"configured duration": {
+			duration:     ptr("2h"),
+			wantDuration: 7200,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			server, fake, endpoint := newAccessKeyProviderServer(ctx, t)
+
+			schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+			require.NoError(t, err)
+			objectType := schemaResp.EphemeralResourceSchemas[accessKeyTypeName].ValueType()
+
+			resp, err := server.OpenEphemeralResource(ctx, &tfprotov6.OpenEphemeralResourceRequest{
+				TypeName: accessKeyTypeName,
+				Config:   accessKeyConfig(t, objectType, tt.duration),
+			})
+			require.NoError(t, err)
+			requireNoDiagErrors(t, resp.Diagnostics, "open")
+
+			result, err := resp.Result.Unmarshal(objectType)
+			require.NoError(t, err)
+			var attrs map[string]tftypes.Value
+			require.NoError(t, result.As(&attrs))
+
+			assert.Equal(t, tftypes.NewValue(tftypes.String, "CWTESTACCESSKEY"), attrs["access_key_id"])
+			assert.Equal(t, tftypes.NewValue(tftypes.String, "test-secret-key"), attrs["secret_access_key"])
+			assert.Equal(t, tftypes.NewValue(tftypes.String, "2030-01-02T03:04:05Z"), attrs["expires_at"])
+			assert.Equal(t, tftypes.NewValue(tftypes.String, endpoint), attrs["endpoint"])
+			assert.Equal(t, []uint32{tt.wantDuration}, fake.durations)
+		})
+	}
+}
+
+func TestAccessKeyEphemeralResource_InvalidDuration(t *testing.T) {
+	ctx := context.Background()
+	server, fake, _ := newAccessKeyProviderServer(ctx, t)
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	objectType := schemaResp.EphemeralResourceSchemas[accessKeyTypeName].ValueType()
+
+	for _, duration := range []string{"forever", "500ms", "-1h"} {
+		resp, err := server.ValidateEphemeralResourceConfig(ctx, &tfprotov6.ValidateEphemeralResourceConfigRequest{
+			TypeName: accessKeyTypeName,
+			Config:   accessKeyConfig(t, objectType, &duration),
+		})
+		require.NoError(t, err)
+		assert.True(t, diagsHaveErrors(resp.Diagnostics), "duration %q should be rejected", duration)
+	}
+
+	assert.Empty(t, fake.durations)
+}
+
+func ptr[T any](v T) *T { return &v }
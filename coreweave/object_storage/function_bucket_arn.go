@@ -0,0 +1,67 @@
+package objectstorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// arnPrefix is the prefix of every bucket and object ARN. CoreWeave AI Object Storage uses the S3 ARN format, with no
+// partition-specific region or account.
+const arnPrefix = "arn:aws:s3:::"
+
+var _ function.Function = &BucketArnFunction{}
+
+func NewBucketArnFunction() function.Function {
+	return &BucketArnFunction{}
+}
+
+// BucketArnFunction builds the ARN of a bucket, for use in bucket and organization access policies.
+type BucketArnFunction struct{}
+
+func (f *BucketArnFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "bucket_arn"
+}
+
+func (f *BucketArnFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build the ARN of a bucket",
+		MarkdownDescription: "Returns the ARN of a bucket, e.g. `arn:aws:s3:::my-bucket`, for use as a `resource` in `coreweave_object_storage_bucket_policy_document` statements. The bucket name may be `*` to match every bucket.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "bucket",
+				MarkdownDescription: "The bucket name.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *BucketArnFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var bucket string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &bucket))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := validateArnBucket(bucket); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, arnPrefix+bucket))
+}
+
+// validateArnBucket checks that bucket can be used as the bucket component of an ARN.
+func validateArnBucket(bucket string) error {
+	if bucket == "" {
+		return errors.New("bucket must not be empty")
+	}
+	if strings.Contains(bucket, "/") {
+		return fmt.Errorf("bucket %q must not contain '/'", bucket)
+	}
+	return nil
+}
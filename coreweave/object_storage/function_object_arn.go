@@ -0,0 +1,57 @@
+package objectstorage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ObjectArnFunction{}
+
+func NewObjectArnFunction() function.Function {
+	return &ObjectArnFunction{}
+}
+
+// ObjectArnFunction builds the ARN of an object, or of a set of objects matched by a key pattern.
+type ObjectArnFunction struct{}
+
+func (f *ObjectArnFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "object_arn"
+}
+
+func (f *ObjectArnFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build the ARN of an object",
+		MarkdownDescription: "Returns the ARN of an object in a bucket, e.g. `arn:aws:s3:::my-bucket/models/*`, for use as a `resource` in `coreweave_object_storage_bucket_policy_document` statements. The key may contain the `*` and `?` wildcards supported by policies.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "bucket",
+				MarkdownDescription: "The bucket name.",
+			},
+			function.StringParameter{
+				Name:                "key",
+				MarkdownDescription: "The object key or key pattern, e.g. `models/*`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ObjectArnFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var bucket, key string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &bucket, &key))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := validateArnBucket(bucket); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if key == "" {
+		resp.Error = function.NewArgumentFuncError(1, "key must not be empty")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, arnPrefix+bucket+"/"+key))
+}
@@ -0,0 +1,83 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseArnFunction{}
+
+func NewParseArnFunction() function.Function {
+	return &ParseArnFunction{}
+}
+
+// ParseArnFunction splits a bucket or object ARN into its bucket and key.
+type ParseArnFunction struct{}
+
+// ParsedArnModel is the result of the parse_arn function.
+type ParsedArnModel struct {
+	Bucket types.String `tfsdk:"bucket"`
+	Key    types.String `tfsdk:"key"`
+}
+
+func (f *ParseArnFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_arn"
+}
+
+func (f *ParseArnFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse a bucket or object ARN",
+		MarkdownDescription: "Splits a bucket ARN such as `arn:aws:s3:::my-bucket`, or an object ARN such as `arn:aws:s3:::my-bucket/models/*`, into an object with `bucket` and `key` attributes. `key` is empty for a bucket ARN.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "The bucket or object ARN.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"bucket": types.StringType,
+				"key":    types.StringType,
+			},
+		},
+	}
+}
+
+func (f *ParseArnFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arn string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arn))
+	if resp.Error != nil {
+		return
+	}
+
+	bucket, key, err := parseArn(arn)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, ParsedArnModel{
+		Bucket: types.StringValue(bucket),
+		Key:    types.StringValue(key),
+	}))
+}
+
+// parseArn splits a bucket or object ARN into its bucket and key. The key is empty for a bucket ARN.
+func parseArn(arn string) (bucket, key string, err error) {
+	resource, ok := strings.CutPrefix(arn, arnPrefix)
+	if !ok {
+		return "", "", fmt.Errorf("ARN %q must begin with %q", arn, arnPrefix)
+	}
+
+	bucket, key, _ = strings.Cut(resource, "/")
+	if bucket == "" {
+		return "", "", fmt.Errorf("ARN %q does not contain a bucket name", arn)
+	}
+
+	return bucket, key, nil
+}
@@ -0,0 +1,85 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const s3URIScheme = "s3://"
+
+var _ function.Function = &ParseS3URIFunction{}
+
+func NewParseS3URIFunction() function.Function {
+	return &ParseS3URIFunction{}
+}
+
+// ParseS3URIFunction splits an s3:// URI into its bucket and path.
+type ParseS3URIFunction struct{}
+
+// ParsedS3URIModel is the result of the parse_s3_uri function.
+type ParsedS3URIModel struct {
+	Bucket types.String `tfsdk:"bucket"`
+	Path   types.String `tfsdk:"path"`
+}
+
+func (f *ParseS3URIFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_s3_uri"
+}
+
+func (f *ParseS3URIFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse an s3:// URI",
+		MarkdownDescription: "Splits a URI such as `s3://my-bucket/models/llama` into an object with `bucket` and `path` attributes, e.g. for the `model` block of `coreweave_inference_deployment`. `path` does not include the leading `/`, and is empty if the URI only names a bucket.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "uri",
+				MarkdownDescription: "The `s3://` URI.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"bucket": types.StringType,
+				"path":   types.StringType,
+			},
+		},
+	}
+}
+
+func (f *ParseS3URIFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var uri string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &uri))
+	if resp.Error != nil {
+		return
+	}
+
+	bucket, path, err := parseS3URI(uri)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, ParsedS3URIModel{
+		Bucket: types.StringValue(bucket),
+		Path:   types.StringValue(path),
+	}))
+}
+
+// parseS3URI splits an s3:// URI into its bucket and path. The path is empty if the URI only names a bucket.
+func parseS3URI(uri string) (bucket, path string, err error) {
+	rest, ok := strings.CutPrefix(uri, s3URIScheme)
+	if !ok {
+		return "", "", fmt.Errorf("URI %q must begin with %q", uri, s3URIScheme)
+	}
+
+	bucket, path, _ = strings.Cut(rest, "/")
+	if bucket == "" {
+		return "", "", fmt.Errorf("URI %q does not contain a bucket name", uri)
+	}
+
+	return bucket, path, nil
+}
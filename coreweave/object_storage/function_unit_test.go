@@ -0,0 +1,112 @@
+package objectstorage
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func runFunction(t *testing.T, f function.Function, result attr.Value, args ...attr.Value) *function.RunResponse {
+	t.Helper()
+
+	resp := &function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(t.Context(), function.RunRequest{Arguments: function.NewArgumentsData(args)}, resp)
+	return resp
+}
+
+func TestBucketArnFunction(t *testing.T) {
+	resp := runFunction(t, NewBucketArnFunction(), types.StringUnknown(), types.StringValue("my-bucket"))
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, types.StringValue("arn:aws:s3:::my-bucket"), resp.Result.Value())
+
+	resp = runFunction(t, NewBucketArnFunction(), types.StringUnknown(), types.StringValue("*"))
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, types.StringValue("arn:aws:s3:::*"), resp.Result.Value())
+
+	for _, bucket := range []string{"", "my-bucket/models"} {
+		resp = runFunction(t, NewBucketArnFunction(), types.StringUnknown(), types.StringValue(bucket))
+		assert.NotNil(t, resp.Error, bucket)
+	}
+}
+
+func TestObjectArnFunction(t *testing.T) {
+	resp := runFunction(t, NewObjectArnFunction(), types.StringUnknown(), types.StringValue("my-bucket"), types.StringValue("models/*"))
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, types.StringValue("arn:aws:s3:::my-bucket/models/*"), resp.Result.Value())
+
+	resp = runFunction(t, NewObjectArnFunction(), types.StringUnknown(), types.StringValue("my-bucket"), types.StringValue(""))
+	assert.NotNil(t, resp.Error)
+}
+
+func TestParseArnFunction(t *testing.T) {
+	tests := map[string]struct {
+		arn        string
+		wantBucket string
+		wantKey    string
+		wantErr    bool
+	}{
+		"bucket":         {arn: "arn:aws:s3:::my-bucket", wantBucket: "my-bucket"},
+		"object":         {arn: "arn:aws:s3:::my-bucket/models/llama.bin", wantBucket: "my-bucket", wantKey: "models/llama.bin"},
+		"wildcard":       {arn: "arn:aws:s3:::my-bucket/*", wantBucket: "my-bucket", wantKey: "*"},
+		"missing bucket": {arn: "arn:aws:s3:::/models", wantErr: true},
+		"wrong service":  {arn: "arn:aws:iam::123456789012:user/alice", wantErr: true},
+		"not an ARN":     {arn: "my-bucket", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := runFunction(t, NewParseArnFunction(), types.ObjectUnknown(map[string]attr.Type{
+				"bucket": types.StringType,
+				"key":    types.StringType,
+			}), types.StringValue(tt.arn))
+
+			if tt.wantErr {
+				assert.NotNil(t, resp.Error)
+				return
+			}
+			assert.Nil(t, resp.Error)
+			assert.Equal(t, types.ObjectValueMust(
+				map[string]attr.Type{"bucket": types.StringType, "key": types.StringType},
+				map[string]attr.Value{"bucket": types.StringValue(tt.wantBucket), "key": types.StringValue(tt.wantKey)},
+			), resp.Result.Value())
+		})
+	}
+}
+
+func TestParseS3URIFunction(t *testing.T) {
+	tests := map[string]struct {
+		uri        string
+		wantBucket string
+		wantPath   string
+		wantErr    bool
+	}{
+		"bucket":         {uri: "s3://my-bucket", wantBucket: "my-bucket"},
+		"bucket slash":   {uri: "s3://my-bucket/", wantBucket: "my-bucket"},
+		"path":           {uri: "s3://my-bucket/models/llama", wantBucket: "my-bucket", wantPath: "models/llama"},
+		"trailing slash": {uri: "s3://my-bucket/models/", wantBucket: "my-bucket", wantPath: "models/"},
+		"missing bucket": {uri: "s3:///models", wantErr: true},
+		"wrong scheme":   {uri: "https://my-bucket.cwobject.com/models", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := runFunction(t, NewParseS3URIFunction(), types.ObjectUnknown(map[string]attr.Type{
+				"bucket": types.StringType,
+				"path":   types.StringType,
+			}), types.StringValue(tt.uri))
+
+			if tt.wantErr {
+				assert.NotNil(t, resp.Error)
+				return
+			}
+			assert.Nil(t, resp.Error)
+			assert.Equal(t, types.ObjectValueMust(
+				map[string]attr.Type{"bucket": types.StringType, "path": types.StringType},
+				map[string]attr.Value{"bucket": types.StringValue(tt.wantBucket), "path": types.StringValue(tt.wantPath)},
+			), resp.Result.Value())
+		})
+	}
+}
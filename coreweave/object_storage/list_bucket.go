@@ -0,0 +1,108 @@
+package objectstorage
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	cwobjectv1 "buf.build/gen/go/coreweave/cwobject/protocolbuffers/go/cwobject/v1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &BucketListResource{}
+	_ list.ListResourceWithConfigure = &BucketListResource{}
+)
+
+func NewBucketListResource() list.ListResource {
+	return &BucketListResource{}
+}
+
+// BucketListResource lists the existing buckets for `terraform query`, so that they can be imported.
+type BucketListResource struct {
+	client *coreweave.Client
+}
+
+type BucketListResourceModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+}
+
+func (l *BucketListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_storage_bucket"
+}
+
+func (l *BucketListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the existing CoreWeave AI Object Storage buckets, optionally filtered by name, to import them. Learn more about [buckets](https://docs.coreweave.com/products/storage/object-storage/buckets/create-bucket).",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": coreweave.ListNameRegexAttribute("buckets"),
+		},
+	}
+}
+
+func (l *BucketListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*coreweave.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *coreweave.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	l.client = client
+}
+
+func (l *BucketListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data BucketListResourceModel
+	diagnostics := req.Config.Get(ctx, &data)
+	nameRegex := coreweave.CompileNameRegex(data.NameRegex, &diagnostics)
+	if diagnostics.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+
+	// ListBucketInfo is not paginated: a single response holds every bucket of the organization.
+	listResp, err := l.client.ListBucketInfo(ctx, connect.NewRequest(&cwobjectv1.ListBucketInfoRequest{}))
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, &diagnostics)
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+
+	infos := slices.DeleteFunc(slices.Clone(listResp.Msg.Info), func(info *cwobjectv1.BucketInfo) bool {
+		return !coreweave.MatchesFilters(nameRegex, types.StringNull(), types.StringNull(), info.GetName(), "", "")
+	})
+	slices.SortFunc(infos, func(a, b *cwobjectv1.BucketInfo) int {
+		return cmp.Compare(a.GetName(), b.GetName())
+	})
+
+	stream.Results = coreweave.ListResults(ctx, req, infos, func(info *cwobjectv1.BucketInfo, result *list.ListResult) {
+		result.DisplayName = info.GetName()
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("name"), info.GetName())...)
+		if !req.IncludeResource {
+			return
+		}
+
+		// the zone and tags of a bucket are read from S3, as on import
+		state := readImportedBucket(ctx, l.client, info.GetName(), &result.Diagnostics)
+		if state == nil {
+			return
+		}
+		state.Timeouts = coreweave.NullTimeouts(ctx)
+		result.Diagnostics.Append(result.Resource.Set(ctx, state)...)
+	})
+}
@@ -0,0 +1,62 @@
+package objectstorage_test
+
+import (
+	"testing"
+
+	objectstorage "github.com/coreweave/terraform-provider-coreweave/coreweave/object_storage"
+	"github.com/coreweave/terraform-provider-coreweave/internal/testutil"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBucketListResource_List(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newBucketDataSourceClient(t, map[string]fakeS3Bucket{
+		"models":   {zone: "US-EAST-04A", tags: map[string]string{"team": "ml"}},
+		"datasets": {zone: "US-WEST-01A"},
+	}, "models", "datasets")
+
+	t.Run("identities, sorted by name", func(t *testing.T) {
+		t.Parallel()
+
+		results := testutil.ListResource(t, objectstorage.NewBucketListResource(), objectstorage.NewBucketResource(), client, nil, false, 0)
+
+		names := make([]string, len(results))
+		for i, result := range results {
+			require.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+
+			var name types.String
+			require.False(t, result.Identity.GetAttribute(t.Context(), path.Root("name"), &name).HasError())
+			names[i] = name.ValueString()
+			assert.Equal(t, result.DisplayName, names[i])
+		}
+		assert.Equal(t, []string{"datasets", "models"}, names)
+	})
+
+	t.Run("with the resource", func(t *testing.T) {
+		t.Parallel()
+
+		results := testutil.ListResource(t, objectstorage.NewBucketListResource(), objectstorage.NewBucketResource(), client, nil, true, 0)
+		require.Len(t, results, 2)
+
+		states := make([]objectstorage.BucketResourceModel, len(results))
+		for i, result := range results {
+			require.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+			require.False(t, result.Resource.Get(t.Context(), &states[i]).HasError())
+			assert.True(t, states[i].Timeouts.IsNull())
+		}
+
+		// an untagged bucket has empty tags rather than failing to read
+		assert.Equal(t, "datasets", states[0].Name.ValueString())
+		assert.Equal(t, "US-WEST-01A", states[0].Zone.ValueString())
+		assert.Empty(t, states[0].TagsAll.Elements())
+
+		assert.Equal(t, "models", states[1].Name.ValueString())
+		assert.Equal(t, "US-EAST-04A", states[1].Zone.ValueString())
+		assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("ml")}), states[1].Tags)
+	})
+}
@@ -0,0 +1,105 @@
+package objectstorage
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	cwobjectv1 "buf.build/gen/go/coreweave/cwobject/protocolbuffers/go/cwobject/v1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &OrganizationAccessPolicyListResource{}
+	_ list.ListResourceWithConfigure = &OrganizationAccessPolicyListResource{}
+)
+
+func NewOrganizationAccessPolicyListResource() list.ListResource {
+	return &OrganizationAccessPolicyListResource{}
+}
+
+// OrganizationAccessPolicyListResource lists the existing organization access policies for `terraform query`, so that
+// they can be imported.
+type OrganizationAccessPolicyListResource struct {
+	client *coreweave.Client
+}
+
+type OrganizationAccessPolicyListResourceModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+}
+
+func (l *OrganizationAccessPolicyListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_storage_organization_access_policy"
+}
+
+func (l *OrganizationAccessPolicyListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the existing [organization access policies](https://docs.coreweave.com/products/storage/object-storage/auth-access/organization-policies/about) of the CoreWeave AI Object Storage organization, optionally filtered by name, to import them.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": coreweave.ListNameRegexAttribute("organization access policies"),
+		},
+	}
+}
+
+func (l *OrganizationAccessPolicyListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*coreweave.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *coreweave.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	l.client = client
+}
+
+func (l *OrganizationAccessPolicyListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data OrganizationAccessPolicyListResourceModel
+	diagnostics := req.Config.Get(ctx, &data)
+	nameRegex := coreweave.CompileNameRegex(data.NameRegex, &diagnostics)
+	if diagnostics.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+
+	// ListAccessPolicies is not paginated: a single response holds every policy of the organization.
+	listResp, err := l.client.ListAccessPolicies(ctx, connect.NewRequest(&cwobjectv1.ListAccessPoliciesRequest{}))
+	if err != nil {
+		coreweave.HandleAPIError(ctx, err, &diagnostics)
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+
+	policies := slices.DeleteFunc(slices.Clone(listResp.Msg.Policies), func(policy *cwobjectv1.CWObjectPolicy) bool {
+		return !coreweave.MatchesFilters(nameRegex, types.StringNull(), types.StringNull(), policy.GetName(), "", "")
+	})
+	slices.SortFunc(policies, func(a, b *cwobjectv1.CWObjectPolicy) int {
+		return cmp.Compare(a.GetName(), b.GetName())
+	})
+
+	stream.Results = coreweave.ListResults(ctx, req, policies, func(policy *cwobjectv1.CWObjectPolicy, result *list.ListResult) {
+		result.DisplayName = policy.GetName()
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("name"), policy.GetName())...)
+		if !req.IncludeResource {
+			return
+		}
+
+		var state OrganizationAccessPolicyResourceModel
+		state.Set(policy)
+		result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+	})
+}
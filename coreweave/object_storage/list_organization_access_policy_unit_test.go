@@ -0,0 +1,70 @@
+package objectstorage_test
+
+import (
+	"testing"
+
+	cwobjectv1 "buf.build/gen/go/coreweave/cwobject/protocolbuffers/go/cwobject/v1"
+	"connectrpc.com/connect"
+	objectstorage "github.com/coreweave/terraform-provider-coreweave/coreweave/object_storage"
+	"github.com/coreweave/terraform-provider-coreweave/internal/testutil"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationAccessPolicyListResource_List(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.NewFakeClient(t)
+	for _, name := range []string{"writers", "readers", "admins"} {
+		_, err := client.EnsureAccessPolicy(t.Context(), connect.NewRequest(&cwobjectv1.EnsureAccessPolicyRequest{
+			Policy: &cwobjectv1.CWObjectPolicy{
+				Name: name,
+				Statements: []*cwobjectv1.CWObjectPolicyStatement{{
+					Name:       "statement",
+					Effect:     "Allow",
+					Actions:    []string{"s3:*"},
+					Resources:  []string{"*"},
+					Principals: []string{"coreweave/" + name},
+				}},
+			},
+		}))
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name   string
+		config map[string]any
+		want   []string
+	}{
+		{name: "all policies, sorted by name", want: []string{"admins", "readers", "writers"}},
+		{name: "by name regex", config: map[string]any{"name_regex": "ers$"}, want: []string{"readers", "writers"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			results := testutil.ListResource(t, objectstorage.NewOrganizationAccessPolicyListResource(), objectstorage.NewOrganizationAccessPolicyResource(), client, tt.config, true, 0)
+
+			names := make([]string, len(results))
+			for i, result := range results {
+				require.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+				names[i] = result.DisplayName
+
+				var name types.String
+				require.False(t, result.Identity.GetAttribute(t.Context(), path.Root("name"), &name).HasError())
+				assert.Equal(t, result.DisplayName, name.ValueString())
+
+				var state objectstorage.OrganizationAccessPolicyResourceModel
+				require.False(t, result.Resource.Get(t.Context(), &state).HasError())
+				require.Len(t, state.Statements, 1)
+				var principals []string
+				require.False(t, state.Statements[0].Principals.ElementsAs(t.Context(), &principals, false).HasError())
+				assert.Equal(t, []string{"coreweave/" + name.ValueString()}, principals)
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
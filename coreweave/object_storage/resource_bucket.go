@@ -5,8 +5,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"net/url"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -15,13 +17,17 @@ import (
 	"github.com/aws/smithy-go/transport/http"
 	"github.com/coreweave/terraform-provider-coreweave/coreweave"
 	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/zclconf/go-cty/cty"
 
 	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
@@ -31,10 +37,19 @@ import (
 var (
 	_ resource.Resource                = &BucketResource{}
 	_ resource.ResourceWithImportState = &BucketResource{}
+	_ resource.ResourceWithModifyPlan  = &BucketResource{}
+	_ resource.ResourceWithIdentity    = &BucketResource{}
 )
 
 const (
 	ErrNoSuchBucket string = "NoSuchBucket"
+	// errNoSuchTagSet is the S3 error code returned for a bucket without tags.
+	errNoSuchTagSet string = "NoSuchTagSet"
+
+	defaultBucketCreateTimeout = 10 * time.Minute
+	defaultBucketReadTimeout   = 5 * time.Minute
+	defaultBucketUpdateTimeout = 5 * time.Minute
+	defaultBucketDeleteTimeout = 5 * time.Minute
 )
 
 func NewBucketResource() resource.Resource {
@@ -47,9 +62,13 @@ type BucketResource struct {
 }
 
 type BucketResourceModel struct {
-	Name types.String `tfsdk:"name"`
-	Zone types.String `tfsdk:"zone"`
-	Tags types.Map    `tfsdk:"tags"`
+	Name    types.String `tfsdk:"name"`
+	Zone    types.String `tfsdk:"zone"`
+	Tags    types.Map    `tfsdk:"tags"`
+	TagsAll types.Map    `tfsdk:"tags_all"`
+
+	AdoptExisting types.Bool     `tfsdk:"adopt_existing"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (b *BucketResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -76,9 +95,29 @@ func (b *BucketResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"tags": schema.MapAttribute{
 				Optional:            true,
-				MarkdownDescription: "Map of tags to assign to the bucket.",
+				MarkdownDescription: "Map of tags to assign to the bucket. Tags with the same key as a provider `default_tags` tag take precedence.",
 				ElementType:         types.StringType,
 			},
+			"tags_all": schema.MapAttribute{
+				Computed:            true,
+				MarkdownDescription: "Map of all tags assigned to the bucket, including those inherited from the provider `default_tags` block, excluding tags ignored by the provider `ignore_tags` block.",
+				ElementType:         types.StringType,
+			},
+			"adopt_existing": coreweave.AdoptExistingAttribute("name"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (b *BucketResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"name": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The name of the bucket.",
+			},
 		},
 	}
 }
@@ -102,6 +141,133 @@ func (b *BucketResource) Configure(_ context.Context, req resource.ConfigureRequ
 	b.client = client
 }
 
+// tagConfig returns the provider tag configuration, which is nil if the provider has not been configured.
+func (b *BucketResource) tagConfig() *coreweave.TagConfig {
+	if b.client == nil {
+		return nil
+	}
+	return b.client.Tags
+}
+
+func (b *BucketResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var tags types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagsAll, diags := planTagsAll(ctx, b.tagConfig(), tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
+
+// planTagsAll merges the provider default tags into the planned resource tags. The result is unknown if any of the
+// resource tags are not yet known.
+func planTagsAll(ctx context.Context, tagConfig *coreweave.TagConfig, tags types.Map) (types.Map, diag.Diagnostics) {
+	if tags.IsUnknown() {
+		return types.MapUnknown(types.StringType), nil
+	}
+	for _, v := range tags.Elements() {
+		if v.IsUnknown() {
+			return types.MapUnknown(types.StringType), nil
+		}
+	}
+
+	tagMap := map[string]string{}
+	if diags := tags.ElementsAs(ctx, &tagMap, false); diags.HasError() {
+		return types.MapNull(types.StringType), diags
+	}
+
+	return tagsValue(tagConfig.Merge(tagMap))
+}
+
+// tagsValue converts tags into a map value, which is null if there are no tags.
+func tagsValue(tags map[string]string) (types.Map, diag.Diagnostics) {
+	if len(tags) == 0 {
+		return types.MapNull(types.StringType), nil
+	}
+
+	tagMap := map[string]attr.Value{}
+	for key, value := range tags {
+		tagMap[key] = types.StringValue(value)
+	}
+	return types.MapValue(types.StringType, tagMap)
+}
+
+// tagSetToMap converts an S3 tag set into a map of keys to values.
+func tagSetToMap(tagSet []s3types.Tag) map[string]string {
+	tags := make(map[string]string, len(tagSet))
+	for _, t := range tagSet {
+		tags[aws.ToString(t.Key)] = aws.ToString(t.Value)
+	}
+	return tags
+}
+
+// configuredTags returns the tags in current that belong in the resource's tags attribute: those that were already
+// configured on the resource, and those that are not inherited unchanged from the provider default tags.
+func configuredTags(tagConfig *coreweave.TagConfig, current, prior map[string]string) map[string]string {
+	tags := map[string]string{}
+	for key, value := range current {
+		if _, ok := prior[key]; ok || !tagConfig.IsDefault(key, value) {
+			tags[key] = value
+		}
+	}
+	return tags
+}
+
+// applyBucketTags replaces the tags of the bucket with desired, keeping any existing tags that the provider is
+// configured to ignore, and waits for the change to propagate.
+func applyBucketTags(ctx context.Context, client *s3.Client, tagConfig *coreweave.TagConfig, bucket string, desired map[string]string, timeout time.Duration) error {
+	final := maps.Clone(desired)
+	if tagConfig.HasIgnored() {
+		out, err := client.GetBucketTagging(ctx, &s3.GetBucketTaggingInput{Bucket: aws.String(bucket)})
+		if err != nil {
+			return err
+		}
+		for key, value := range tagSetToMap(out.TagSet) {
+			if _, ok := final[key]; !ok && tagConfig.IsIgnored(key) {
+				final[key] = value
+			}
+		}
+	}
+
+	tags := []s3types.Tag{}
+	for key, value := range final {
+		tags = append(tags, s3types.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(value),
+		})
+	}
+
+	if len(tags) == 0 {
+		if _, err := client.DeleteBucketTagging(ctx, &s3.DeleteBucketTaggingInput{
+			Bucket: aws.String(bucket),
+		}); err != nil {
+			return err
+		}
+	} else {
+		if _, err := client.PutBucketTagging(ctx, &s3.PutBucketTaggingInput{
+			Bucket: aws.String(bucket),
+			Tagging: &s3types.Tagging{
+				TagSet: tags,
+			},
+		}); err != nil {
+			return err
+		}
+	}
+
+	return waitForBucketTags(ctx, client, bucket, tags, timeout)
+}
+
 func handleS3Error(
 	err error,
 	diags *diag.Diagnostics,
@@ -152,16 +318,16 @@ func handleS3Error(
 
 // waitForBucket polls HeadBucket every 'interval' until the bucket
 // either exists (shouldExist=true) or is deleted (shouldExist=false),
-// or the context times out/cancels.
+// or the timeout elapses/the context cancels.
 //
 // Returns nil on the desired state; any other error (including timeout)
 // is returned directly.
-func waitForBucket(parentCtx context.Context, client *s3.Client, bucket string, shouldExist bool) error {
+func waitForBucket(parentCtx context.Context, client *s3.Client, bucket string, shouldExist bool, timeout time.Duration) error {
 	operation := "bucket creation"
 	if !shouldExist {
 		operation = "bucket deletion"
 	}
-	return coreweave.PollUntil(operation, parentCtx, 5*time.Second, 5*time.Minute, func(ctx context.Context) (bool, error) {
+	return coreweave.PollUntil(operation, parentCtx, 5*time.Second, timeout, func(ctx context.Context) (bool, error) {
 		_, err := client.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: aws.String(bucket)})
 
 		// desired state: exists
@@ -236,12 +402,12 @@ func eqTag(x, y s3types.Tag) bool {
 	return eqPtr(x.Key, y.Key) && eqPtr(x.Value, y.Value)
 }
 
-func waitForBucketTags(parentCtx context.Context, client *s3.Client, bucket string, expected []s3types.Tag) error {
+func waitForBucketTags(parentCtx context.Context, client *s3.Client, bucket string, expected []s3types.Tag, timeout time.Duration) error {
 	// make a sorted copy of expected
 	exp := append([]s3types.Tag(nil), expected...)
 	slices.SortFunc(exp, cmpTag)
 
-	return coreweave.PollUntil("bucket tag propagation", parentCtx, 5*time.Second, 5*time.Minute, func(ctx context.Context) (bool, error) {
+	return coreweave.PollUntil("bucket tag propagation", parentCtx, 5*time.Second, timeout, func(ctx context.Context) (bool, error) {
 		out, err := client.GetBucketTagging(ctx, &s3.GetBucketTaggingInput{Bucket: aws.String(bucket)})
 		if err != nil {
 			if isTransientS3Error(err) {
@@ -263,6 +429,29 @@ func (b *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultBucketCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagMap := map[string]string{}
+	if diag := data.Tags.ElementsAs(ctx, &tagMap, false); diag.HasError() {
+		detail := diag.Errors()[0].Detail()
+		resp.Diagnostics.AddError("Invalid S3 Bucket Tags", detail)
+		return
+	}
+	tagsAll := b.tagConfig().Merge(tagMap)
+
+	data.TagsAll, diags = tagsValue(tagsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	s3Client, err := b.client.S3Client(ctx, data.Zone.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create S3 client", err.Error())
@@ -273,18 +462,104 @@ func (b *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 	// if you try to create a bucket with the same name in a different zone.
 	// If a CreateBucket request is sent for a name/zone combo that already exists, it will succeed.
 	// So we HeadBucket here and check if the request succeeds; if it does, error and tell the user the bucket already exists
-	// so they can import the existing state
+	// so they can import the existing state, unless it is to be adopted
 	_, err = s3Client.HeadBucket(ctx, &s3.HeadBucketInput{
 		Bucket: aws.String(data.Name.ValueString()),
 	})
+	adopted := false
 	if err == nil {
-		resp.Diagnostics.AddError(
+		if !data.AdoptExisting.ValueBool() {
+			resp.Diagnostics.AddError(
+				"Already Exists",
+				fmt.Sprintf("Bucket '%s' already exists, specify a different name and try again, or import the bucket using `terraform import`.", data.Name.ValueString()),
+			)
+			return
+		}
+		if !checkBucketAdoptable(ctx, s3Client, b.tagConfig(), &data, tagsAll, &resp.Diagnostics) {
+			return
+		}
+		adopted = true
+	}
+
+	if !adopted && !createBucket(ctx, s3Client, &data, &resp.Diagnostics) {
+		return
+	}
+
+	// set state while we wait for the bucket to finish
+	if diag := resp.State.Set(ctx, &data); diag.HasError() {
+		// if we fail to set state, return early as the resource will be orphaned
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("name"), data.Name)...)
+
+	if err := waitForBucket(ctx, s3Client, data.Name.ValueString(), true, createTimeout); err != nil {
+		handleS3Error(err, &resp.Diagnostics, data.Name.ValueString())
+		return
+	}
+
+	// the tags of an adopted bucket already match the configuration
+	if len(tagsAll) > 0 && !adopted {
+		if err := applyBucketTags(ctx, s3Client, b.tagConfig(), data.Name.ValueString(), tagsAll, createTimeout); err != nil {
+			handleS3Error(err, &resp.Diagnostics, data.Name.ValueString())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// checkBucketAdoptable reports whether the existing bucket named by data can be adopted into state, i.e. whether it is
+// in the configured zone and has the configured tags, tagsAll, apart from ignored ones. It adds an error if it cannot.
+func checkBucketAdoptable(ctx context.Context, s3Client *s3.Client, tagConfig *coreweave.TagConfig, data *BucketResourceModel, tagsAll map[string]string, diagnostics *diag.Diagnostics) bool {
+	name := data.Name.ValueString()
+	location, err := s3Client.GetBucketLocation(ctx, &s3.GetBucketLocationInput{
+		Bucket: aws.String(name),
+	})
+	if err != nil {
+		handleS3Error(err, diagnostics, name)
+		return false
+	}
+
+	current := map[string]string{}
+	tagging, err := s3Client.GetBucketTagging(ctx, &s3.GetBucketTaggingInput{
+		Bucket: aws.String(name),
+	})
+	var apiErr smithy.APIError
+	switch {
+	case errors.As(err, &apiErr) && apiErr.ErrorCode() == errNoSuchTagSet:
+		// the bucket has no tags
+	case err != nil:
+		handleS3Error(err, diagnostics, name)
+		return false
+	default:
+		current = tagConfig.WithoutIgnored(tagSetToMap(tagging.TagSet))
+	}
+
+	var mismatches []string
+	if string(location.LocationConstraint) != data.Zone.ValueString() {
+		mismatches = append(mismatches, "zone")
+	}
+	if !maps.Equal(current, tagConfig.WithoutIgnored(tagsAll)) {
+		mismatches = append(mismatches, "tags")
+	}
+	if len(mismatches) > 0 {
+		diagnostics.AddError(
 			"Already Exists",
-			fmt.Sprintf("Bucket '%s' already exists, specify a different name and try again, or import the bucket using `terraform import`.", data.Name.ValueString()),
+			fmt.Sprintf(
+				"Bucket '%s' already exists, but cannot be adopted because it differs from the configuration in: %s. Change the configuration to match it, or delete it and try again.",
+				name, strings.Join(mismatches, ", "),
+			),
 		)
-		return
+		return false
 	}
 
+	tflog.Info(ctx, "adopting existing bucket", map[string]interface{}{"name": name})
+	return true
+}
+
+// createBucket creates the bucket described by data. It adds an error and returns false if that fails.
+func createBucket(ctx context.Context, s3Client *s3.Client, data *BucketResourceModel, diagnostics *diag.Diagnostics) bool {
 	createReq := &s3.CreateBucketInput{
 		Bucket: aws.String(data.Name.ValueString()),
 		CreateBucketConfiguration: &s3types.CreateBucketConfiguration{
@@ -292,7 +567,7 @@ func (b *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 		},
 	}
 
-	_, err = s3Client.CreateBucket(ctx, createReq)
+	_, err := s3Client.CreateBucket(ctx, createReq)
 	if err != nil {
 		// These two error types are only returned in a situation where a user
 		// tries to create a bucket with the same name but a different zone.
@@ -307,71 +582,18 @@ func (b *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 				message = bucketOwnedByYouErr.Message
 			}
 
-			resp.Diagnostics.AddError(
+			diagnostics.AddError(
 				"Already Exists",
 				fmt.Sprintf("Bucket '%s' already exists, specify a different name and try again, or import the bucket using `terraform import`: %s", data.Name.ValueString(), *message),
 			)
-			return
-		}
-
-		handleS3Error(err, &resp.Diagnostics, data.Name.ValueString())
-		return
-	}
-
-	// set state while we wait for the bucket to finish
-	if diag := resp.State.Set(ctx, &data); diag.HasError() {
-		// if we fail to set state, return early as the resource will be orphaned
-		resp.Diagnostics.Append(diag...)
-		return
-	}
-
-	if err := waitForBucket(ctx, s3Client, data.Name.ValueString(), true); err != nil {
-		handleS3Error(err, &resp.Diagnostics, data.Name.ValueString())
-		return
-	}
-
-	if !data.Tags.IsNull() {
-		tags := []s3types.Tag{}
-		tagMap := map[string]string{}
-
-		if diag := data.Tags.ElementsAs(ctx, &tagMap, false); diag.HasError() {
-			detail := diag.Errors()[0].Detail()
-			resp.Diagnostics.AddError("Invalid S3 Bucket Tags", detail)
-			return
-		}
-
-		for key, value := range tagMap {
-			tags = append(tags, s3types.Tag{
-				Key:   aws.String(key),
-				Value: aws.String(value),
-			})
-		}
-
-		_, err = s3Client.PutBucketTagging(ctx, &s3.PutBucketTaggingInput{
-			Bucket: data.Name.ValueStringPointer(),
-			Tagging: &s3types.Tagging{
-				TagSet: tags,
-			},
-		})
-		if err != nil {
-			handleS3Error(err, &resp.Diagnostics, data.Name.ValueString())
-			return
-		}
-
-		// set state while we wait for the tags to propagate
-		if diag := resp.State.Set(ctx, &data); diag.HasError() {
-			// if we fail to set state, return early as the resource will be orphaned
-			resp.Diagnostics.Append(diag...)
-			return
+			return false
 		}
 
-		if err := waitForBucketTags(ctx, s3Client, data.Name.ValueString(), tags); err != nil {
-			handleS3Error(err, &resp.Diagnostics, data.Name.ValueString())
-			return
-		}
+		handleS3Error(err, diagnostics, data.Name.ValueString())
+		return false
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return true
 }
 
 func (b *BucketResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -382,6 +604,15 @@ func (b *BucketResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultBucketReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	s3Client, err := b.client.S3Client(ctx, data.Zone.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create S3 client", err.Error())
@@ -422,24 +653,25 @@ func (b *BucketResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	tags := types.MapNull(types.StringType)
-	if len(tagSet.TagSet) > 0 {
-		tagMap := map[string]attr.Value{}
-		for _, t := range tagSet.TagSet {
-			tagMap[*t.Key] = types.StringValue(*t.Value)
-		}
-		tagMapValue, diag := types.MapValue(types.StringType, tagMap)
-		resp.Diagnostics.Append(diag...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		tags = tagMapValue
+	priorTags := map[string]string{}
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &priorTags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
+	current := b.tagConfig().WithoutIgnored(tagSetToMap(tagSet.TagSet))
+
 	data.Zone = types.StringValue(string(location.LocationConstraint))
-	data.Tags = tags
+	data.Tags, diags = tagsValue(configuredTags(b.tagConfig(), current, priorTags))
+	resp.Diagnostics.Append(diags...)
+	data.TagsAll, diags = tagsValue(current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("name"), data.Name)...)
 }
 
 func (b *BucketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -450,60 +682,41 @@ func (b *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultBucketUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	s3Client, err := b.client.S3Client(ctx, data.Zone.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create S3 client", err.Error())
 		return
 	}
 
-	if !data.Tags.IsNull() {
-		tags := []s3types.Tag{}
-		tagMap := map[string]string{}
-
-		if diag := data.Tags.ElementsAs(ctx, &tagMap, false); diag.HasError() {
-			resp.Diagnostics.Append(diag...)
-			return
-		}
-
-		for key, value := range tagMap {
-			tags = append(tags, s3types.Tag{
-				Key:   aws.String(key),
-				Value: aws.String(value),
-			})
-		}
-		_, err = s3Client.PutBucketTagging(ctx, &s3.PutBucketTaggingInput{
-			Bucket: aws.String(data.Name.ValueString()),
-			Tagging: &s3types.Tagging{
-				TagSet: tags,
-			},
-		})
-		if err != nil {
-			handleS3Error(err, &resp.Diagnostics, data.Name.ValueString())
-			return
-		}
-
-		if err := waitForBucketTags(ctx, s3Client, data.Name.ValueString(), tags); err != nil {
-			handleS3Error(err, &resp.Diagnostics, data.Name.ValueString())
-			return
-		}
-	} else {
-		_, err = s3Client.DeleteBucketTagging(ctx, &s3.DeleteBucketTaggingInput{
-			Bucket: aws.String(data.Name.ValueString()),
-		})
-		if err != nil {
-			handleS3Error(err, &resp.Diagnostics, data.Name.ValueString())
-			return
-		}
+	tagMap := map[string]string{}
+	if diag := data.Tags.ElementsAs(ctx, &tagMap, false); diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+	tagsAll := b.tagConfig().Merge(tagMap)
 
-		if err := waitForBucketTags(ctx, s3Client, data.Name.ValueString(), []s3types.Tag{}); err != nil {
-			handleS3Error(err, &resp.Diagnostics, data.Name.ValueString())
-			return
-		}
+	if err := applyBucketTags(ctx, s3Client, b.tagConfig(), data.Name.ValueString(), tagsAll, updateTimeout); err != nil {
+		handleS3Error(err, &resp.Diagnostics, data.Name.ValueString())
+		return
+	}
 
-		data.Tags = types.MapNull(types.StringType)
+	data.TagsAll, diags = tagsValue(tagsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("name"), data.Name)...)
 }
 
 func (b *BucketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -514,6 +727,15 @@ func (b *BucketResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultBucketDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	s3Client, err := b.client.S3Client(ctx, data.Zone.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create S3 client", err.Error())
@@ -535,56 +757,82 @@ func (b *BucketResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	// wait for bucket to fully finish deleting
-	if err := waitForBucket(ctx, s3Client, data.Name.ValueString(), false); err != nil {
+	if err := waitForBucket(ctx, s3Client, data.Name.ValueString(), false, deleteTimeout); err != nil {
 		handleS3Error(err, &resp.Diagnostics, data.Name.ValueString())
 		return
 	}
 }
 
 func (b *BucketResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	s3Client, err := b.client.S3Client(ctx, "")
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to create S3 client", err.Error())
+	name := req.ID
+	if name == "" {
+		// imported by identity, from an import block with the identity attribute set
+		var identity types.String
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("name"), &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		name = identity.ValueString()
+	}
+
+	data := readImportedBucket(ctx, b.client, name, &resp.Diagnostics)
+	if data == nil {
 		return
 	}
 
-	bucket, err := s3Client.GetBucketLocation(ctx, &s3.GetBucketLocationInput{
-		Bucket: aws.String(req.ID),
-	})
+	// set attributes individually so the timeouts block is left null on import
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), data.Name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), data.Zone)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tags"), data.Tags)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tags_all"), data.TagsAll)...)
+}
+
+// readImportedBucket reads the existing bucket with the given name as it is imported, i.e. with every tag that is not
+// ignored taken as configured, and the timeouts block unset. It adds an error and returns nil if the bucket cannot be
+// read.
+func readImportedBucket(ctx context.Context, client *coreweave.Client, name string, diagnostics *diag.Diagnostics) *BucketResourceModel {
+	s3Client, err := client.S3Client(ctx, "")
 	if err != nil {
-		handleS3Error(err, &resp.Diagnostics, req.ID)
-		return
+		diagnostics.AddError("Failed to create S3 client", err.Error())
+		return nil
 	}
 
-	bucketTagging, err := s3Client.GetBucketTagging(ctx, &s3.GetBucketTaggingInput{
-		Bucket: aws.String(req.ID),
+	bucket, err := s3Client.GetBucketLocation(ctx, &s3.GetBucketLocationInput{
+		Bucket: aws.String(name),
 	})
 	if err != nil {
-		handleS3Error(err, &resp.Diagnostics, req.ID)
-		return
+		handleS3Error(err, diagnostics, name)
+		return nil
 	}
 
-	tags := types.MapNull(types.StringType)
-	if len(bucketTagging.TagSet) > 0 {
-		tagMap := map[string]attr.Value{}
-		for _, t := range bucketTagging.TagSet {
-			tagMap[*t.Key] = types.StringValue(*t.Value)
-		}
-		tagMapValue, diag := types.MapValue(types.StringType, tagMap)
-		resp.Diagnostics.Append(diag...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		tags = tagMapValue
+	current := map[string]string{}
+	bucketTagging, err := s3Client.GetBucketTagging(ctx, &s3.GetBucketTaggingInput{
+		Bucket: aws.String(name),
+	})
+	var apiErr smithy.APIError
+	switch {
+	case errors.As(err, &apiErr) && apiErr.ErrorCode() == errNoSuchTagSet:
+		// the bucket has no tags
+	case err != nil:
+		handleS3Error(err, diagnostics, name)
+		return nil
+	default:
+		current = client.Tags.WithoutIgnored(tagSetToMap(bucketTagging.TagSet))
 	}
 
-	data := BucketResourceModel{
-		Name: types.StringValue(req.ID),
+	data := &BucketResourceModel{
+		Name: types.StringValue(name),
 		Zone: types.StringValue(string(bucket.LocationConstraint)),
-		Tags: tags,
 	}
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	var diags diag.Diagnostics
+	data.Tags, diags = tagsValue(configuredTags(client.Tags, current, nil))
+	diagnostics.Append(diags...)
+	data.TagsAll, diags = tagsValue(current)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return nil
+	}
+	return data
 }
 
 // MustRenderBucketResource is a helper to render HCL for use in acceptance testing.
@@ -56,6 +56,7 @@ func createBucketTestStep(ctx context.Context, t *testing.T, opts bucketTestStep
 	}
 
 	tagCheck := statecheck.ExpectKnownValue(fullResourceName, tfjsonpath.New("tags"), knownvalue.Null())
+	tagsAllCheck := statecheck.ExpectKnownValue(fullResourceName, tfjsonpath.New("tags_all"), knownvalue.Null())
 
 	if !opts.Bucket.Tags.IsNull() {
 		tagMap := map[string]string{}
@@ -66,9 +67,11 @@ func createBucketTestStep(ctx context.Context, t *testing.T, opts bucketTestStep
 			tagCheckMap[key] = knownvalue.StringExact(value)
 		}
 		tagCheck = statecheck.ExpectKnownValue(fullResourceName, tfjsonpath.New("tags"), knownvalue.MapExact(tagCheckMap))
+		// the provider under test has no default_tags, so tags_all matches tags
+		tagsAllCheck = statecheck.ExpectKnownValue(fullResourceName, tfjsonpath.New("tags_all"), knownvalue.MapExact(tagCheckMap))
 	}
 
-	statechecks = append(statechecks, tagCheck)
+	statechecks = append(statechecks, tagCheck, tagsAllCheck)
 
 	return resource.TestStep{
 		PreConfig: func() {
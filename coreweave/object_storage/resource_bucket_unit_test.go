@@ -0,0 +1,260 @@
+package objectstorage
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanTagsAll(t *testing.T) {
+	config := &coreweave.TagConfig{DefaultTags: map[string]string{"team": "ml", "env": "prod"}}
+
+	tests := map[string]struct {
+		config *coreweave.TagConfig
+		tags   types.Map
+		want   types.Map
+	}{
+		"no tags": {
+			tags: types.MapNull(types.StringType),
+			want: types.MapNull(types.StringType),
+		},
+		"resource tags only": {
+			tags: types.MapValueMust(types.StringType, map[string]attr.Value{"app": types.StringValue("web")}),
+			want: types.MapValueMust(types.StringType, map[string]attr.Value{"app": types.StringValue("web")}),
+		},
+		"default tags only": {
+			config: config,
+			tags:   types.MapNull(types.StringType),
+			want: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"team": types.StringValue("ml"),
+				"env":  types.StringValue("prod"),
+			}),
+		},
+		"resource tags override defaults": {
+			config: config,
+			tags:   types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringValue("dev")}),
+			want: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"team": types.StringValue("ml"),
+				"env":  types.StringValue("dev"),
+			}),
+		},
+		"unknown tags": {
+			config: config,
+			tags:   types.MapUnknown(types.StringType),
+			want:   types.MapUnknown(types.StringType),
+		},
+		"unknown tag value": {
+			config: config,
+			tags:   types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringUnknown()}),
+			want:   types.MapUnknown(types.StringType),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, diags := planTagsAll(t.Context(), tt.config, tt.tags)
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestConfiguredTags(t *testing.T) {
+	config := &coreweave.TagConfig{DefaultTags: map[string]string{"team": "ml", "env": "prod"}}
+
+	tests := map[string]struct {
+		current map[string]string
+		prior   map[string]string
+		want    map[string]string
+	}{
+		"defaults are excluded": {
+			current: map[string]string{"team": "ml", "env": "prod", "app": "web"},
+			want:    map[string]string{"app": "web"},
+		},
+		"overridden defaults are kept": {
+			current: map[string]string{"team": "ml", "env": "dev"},
+			want:    map[string]string{"env": "dev"},
+		},
+		"defaults set on the resource are kept": {
+			current: map[string]string{"team": "ml", "env": "prod"},
+			prior:   map[string]string{"env": "prod"},
+			want:    map[string]string{"env": "prod"},
+		},
+		"drift is reported": {
+			current: map[string]string{"app": "api"},
+			prior:   map[string]string{"app": "web"},
+			want:    map[string]string{"app": "api"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, configuredTags(config, tt.current, tt.prior))
+		})
+	}
+}
+
+// existingBucketS3 serves the path-style S3 calls that read an existing bucket with the given zone and tags, and
+// records every call that would change it.
+type existingBucketS3 struct {
+	zone string
+	tags map[string]string
+
+	mu     sync.Mutex
+	writes []string
+}
+
+func (f *existingBucketS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	switch {
+	case r.Method == http.MethodHead:
+		w.Header().Set("X-Amz-Bucket-Region", f.zone)
+	case r.Method != http.MethodGet:
+		f.mu.Lock()
+		f.writes = append(f.writes, r.Method+" "+r.URL.String())
+		f.mu.Unlock()
+	case query.Has("location"):
+		fmt.Fprintf(w, "<LocationConstraint>%s</LocationConstraint>", f.zone)
+	case query.Has("tagging") && len(f.tags) == 0:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `<Error><Code>NoSuchTagSet</Code><Message>The TagSet does not exist</Message></Error>`)
+	case query.Has("tagging"):
+		var tags strings.Builder
+		for key, value := range f.tags {
+			fmt.Fprintf(&tags, "<Tag><Key>%s</Key><Value>%s</Value></Tag>", key, value)
+		}
+		fmt.Fprintf(w, "<Tagging><TagSet>%s</TagSet></Tagging>", tags.String())
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func (f *existingBucketS3) recordedWrites() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.writes
+}
+
+func newExistingBucketS3(t *testing.T, zone string, tags map[string]string) (*existingBucketS3, string) {
+	t.Helper()
+
+	fake := &existingBucketS3{zone: zone, tags: tags}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	return fake, server.URL
+}
+
+func TestCheckBucketAdoptable(t *testing.T) {
+	config := &coreweave.TagConfig{IgnoreKeys: []string{"owner"}}
+
+	tests := map[string]struct {
+		zone    string
+		tags    map[string]string
+		tagsAll map[string]string
+		wantErr string
+	}{
+		"matching bucket": {
+			zone:    "US-EAST-04A",
+			tags:    map[string]string{"team": "ml"},
+			tagsAll: map[string]string{"team": "ml"},
+		},
+		"untagged bucket": {
+			zone: "US-EAST-04A",
+		},
+		"ignored tag": {
+			zone:    "US-EAST-04A",
+			tags:    map[string]string{"team": "ml", "owner": "platform"},
+			tagsAll: map[string]string{"team": "ml"},
+		},
+		"extra tag": {
+			zone:    "US-EAST-04A",
+			tags:    map[string]string{"team": "ml", "env": "prod"},
+			tagsAll: map[string]string{"team": "ml"},
+			wantErr: "differs from the configuration in: tags.",
+		},
+		"missing tag": {
+			zone:    "US-EAST-04A",
+			tagsAll: map[string]string{"team": "ml"},
+			wantErr: "differs from the configuration in: tags.",
+		},
+		"other zone": {
+			zone:    "US-WEST-01A",
+			tags:    map[string]string{"team": "ml"},
+			tagsAll: map[string]string{"team": "ml"},
+			wantErr: "differs from the configuration in: zone.",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fake, endpoint := newExistingBucketS3(t, tt.zone, tt.tags)
+			s3Client := s3.New(s3.Options{
+				BaseEndpoint: aws.String(endpoint),
+				Region:       "US-EAST-04A",
+				UsePathStyle: true,
+				Credentials:  aws.AnonymousCredentials{},
+			})
+			data := &BucketResourceModel{Name: types.StringValue("models"), Zone: types.StringValue("US-EAST-04A")}
+
+			var diagnostics diag.Diagnostics
+			adoptable := checkBucketAdoptable(t.Context(), s3Client, config, data, tt.tagsAll, &diagnostics)
+
+			assert.Empty(t, fake.recordedWrites())
+			if tt.wantErr != "" {
+				assert.False(t, adoptable)
+				require.Len(t, diagnostics, 1)
+				assert.Contains(t, diagnostics[0].Detail(), tt.wantErr)
+				return
+			}
+			assert.True(t, adoptable)
+			assert.Empty(t, diagnostics)
+		})
+	}
+}
+
+func TestBucketResource_CreateAdoptsOnlyMatchingTags(t *testing.T) {
+	ctx := t.Context()
+
+	fake, endpoint := newExistingBucketS3(t, "US-EAST-04A", map[string]string{"team": "ml", "env": "prod"})
+	client, err := coreweave.NewClient(endpoint, endpoint, 10*time.Second, coreweave.RetryConfig{}, coreweave.TransportConfig{})
+	require.NoError(t, err)
+	client.S3 = coreweave.S3Config{AccessKeyID: "test", SecretAccessKey: "test", UsePathStyle: true, SkipCredentialsValidation: true}
+	r := &BucketResource{client: client}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	values["name"] = tftypes.NewValue(tftypes.String, "models")
+	values["zone"] = tftypes.NewValue(tftypes.String, "US-EAST-04A")
+	values["tags"] = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{"team": tftypes.NewValue(tftypes.String, "ml")})
+	values["adopt_existing"] = tftypes.NewValue(tftypes.Bool, true)
+
+	req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}}
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	r.Create(ctx, req, &resp)
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "cannot be adopted because it differs from the configuration in: tags.")
+	assert.Empty(t, fake.recordedWrites(), "the tags of the existing bucket are not rewritten")
+	assert.True(t, resp.State.Raw.IsNull(), "the bucket is not adopted")
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -25,6 +26,7 @@ import (
 var (
 	_ resource.Resource                = &OrganizationAccessPolicyResource{}
 	_ resource.ResourceWithImportState = &OrganizationAccessPolicyResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationAccessPolicyResource{}
 )
 
 const (
@@ -220,6 +222,17 @@ func (o *OrganizationAccessPolicyResource) Schema(ctx context.Context, req resou
 	}
 }
 
+func (o *OrganizationAccessPolicyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"name": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The name of the organization access policy.",
+			},
+		},
+	}
+}
+
 func (o *OrganizationAccessPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -260,6 +273,7 @@ func (o *OrganizationAccessPolicyResource) Create(ctx context.Context, req resou
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("name"), data.Name)...)
 }
 
 func (o *OrganizationAccessPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -280,6 +294,7 @@ func (o *OrganizationAccessPolicyResource) Read(ctx context.Context, req resourc
 		if p.Name == data.Name.ValueString() {
 			data.Set(p)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("name"), data.Name)...)
 			return
 		}
 	}
@@ -309,6 +324,7 @@ func (o *OrganizationAccessPolicyResource) Update(ctx context.Context, req resou
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("name"), data.Name)...)
 }
 
 func (o *OrganizationAccessPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -334,6 +350,17 @@ func (o *OrganizationAccessPolicyResource) Delete(ctx context.Context, req resou
 }
 
 func (o *OrganizationAccessPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	name := req.ID
+	if name == "" {
+		// imported by identity, from an import block with the identity attribute set
+		var identity types.String
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("name"), &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		name = identity.ValueString()
+	}
+
 	policies, err := o.client.ListAccessPolicies(ctx, &connect.Request[cwobjectv1.ListAccessPoliciesRequest]{})
 	if err != nil {
 		coreweave.HandleAPIError(ctx, err, &resp.Diagnostics)
@@ -341,7 +368,7 @@ func (o *OrganizationAccessPolicyResource) ImportState(ctx context.Context, req
 	}
 
 	for _, p := range policies.Msg.Policies {
-		if p.Name == req.ID {
+		if p.Name == name {
 			data := OrganizationAccessPolicyResourceModel{}
 			data.Set(p)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -349,7 +376,7 @@ func (o *OrganizationAccessPolicyResource) ImportState(ctx context.Context, req
 		}
 	}
 
-	resp.Diagnostics.AddError("Organization access policy not found", fmt.Sprintf("organization access policy with name %q not found, verify the name & try again. ", req.ID))
+	resp.Diagnostics.AddError("Organization access policy not found", fmt.Sprintf("organization access policy with name %q not found, verify the name & try again. ", name))
 }
 
 type effectValidator struct{}
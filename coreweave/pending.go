@@ -0,0 +1,163 @@
+package coreweave
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// pendingOperationKey is the private state key of the PendingOperation of a resource.
+const pendingOperationKey = "pending_operation"
+
+// PrivateState is the private state of a resource instance, i.e. the Private field of a resource request or response.
+type PrivateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// PendingOperation is an operation on a resource, e.g. "create", that was started but not waited for until it
+// finished, because Terraform was interrupted or timed out while waiting. It is recorded in the private state of the
+// resource, so that later runs can resume waiting for it rather than treat the resource as ready.
+type PendingOperation struct {
+	Operation string    `json:"operation"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+// SetPendingOperation records operation as the pending operation of the resource, before waiting for it to finish.
+func SetPendingOperation(ctx context.Context, private PrivateState, operation string) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	value, err := json.Marshal(PendingOperation{Operation: operation, StartedAt: time.Now().UTC()})
+	if err != nil {
+		diagnostics.AddError("Failed to Record Pending Operation", err.Error())
+		return diagnostics
+	}
+
+	return private.SetKey(ctx, pendingOperationKey, value)
+}
+
+// ClearPendingOperation removes the pending operation of the resource, once it has finished.
+func ClearPendingOperation(ctx context.Context, private PrivateState) diag.Diagnostics {
+	return private.SetKey(ctx, pendingOperationKey, nil)
+}
+
+// GetPendingOperation returns the pending operation of the resource, or nil if there is none. A pending operation that
+// cannot be decoded is logged and ignored, as it cannot be resumed anyway.
+func GetPendingOperation(ctx context.Context, private PrivateState) (*PendingOperation, diag.Diagnostics) {
+	value, diagnostics := private.GetKey(ctx, pendingOperationKey)
+	if diagnostics.HasError() || len(value) == 0 {
+		return nil, diagnostics
+	}
+
+	var op PendingOperation
+	if err := json.Unmarshal(value, &op); err != nil {
+		tflog.Warn(ctx, "ignoring pending operation that cannot be decoded", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, diagnostics
+	}
+
+	return &op, diagnostics
+}
+
+// Remaining returns how much of timeout is left for the operation, counted from when it started.
+func (op *PendingOperation) Remaining(timeout time.Duration) time.Duration {
+	return timeout - time.Since(op.StartedAt)
+}
+
+// IsInterrupted reports whether err, returned while waiting for an operation, is due to Terraform being interrupted
+// rather than to the operation failing or timing out.
+func IsInterrupted(err error) bool {
+	return errors.Is(err, context.Canceled)
+}
+
+// IsTimeout reports whether err, returned while waiting for an operation, is due to the wait timing out while the
+// operation was still in progress.
+func IsTimeout(err error) bool {
+	var timeoutErr *retry.TimeoutError
+	return errors.As(err, &timeoutErr)
+}
+
+// AddInterruptedWarning warns that Terraform was interrupted while waiting for operation on the resourceType with the
+// given id. The operation is left pending rather than failed, which would taint a resource being created, so that the
+// next run resumes waiting for it.
+func AddInterruptedWarning(diagnostics *diag.Diagnostics, resourceType, id, operation string) {
+	diagnostics.AddWarning(
+		"Operation Interrupted",
+		fmt.Sprintf(
+			"Terraform was interrupted while waiting for the %s of %s '%s' to finish. The next run resumes waiting for it.",
+			operation, resourceType, id,
+		),
+	)
+}
+
+// AddPendingOperationWarning warns that op is still in progress on the resourceType with the given id, as it is in
+// status, so that a resource whose create did not finish is not silently mistaken for a ready one.
+func AddPendingOperationWarning(diagnostics *diag.Diagnostics, resourceType, id string, op *PendingOperation, status string) {
+	diagnostics.AddWarning(
+		"Operation Still In Progress",
+		fmt.Sprintf(
+			"The %s of %s '%s', started at %s, has not finished, and the %s is still %s. The next apply that changes the %s waits for the %s to finish first.",
+			op.Operation, resourceType, id, op.StartedAt.Format(time.RFC3339), resourceType, status, resourceType, op.Operation,
+		),
+	)
+}
+
+// AddPendingOperationFailedWarning warns that op on the resourceType with the given id did not finish successfully,
+// as the resourceType ended in status, so that a failed resource is not silently mistaken for a ready one once the
+// operation is no longer pending.
+func AddPendingOperationFailedWarning(diagnostics *diag.Diagnostics, resourceType, id string, op *PendingOperation, status string) {
+	diagnostics.AddWarning(
+		"Operation Failed",
+		fmt.Sprintf(
+			"The %s of %s '%s', started at %s, did not finish successfully, and the %s is %s. Replace or delete the %s.",
+			op.Operation, resourceType, id, op.StartedAt.Format(time.RFC3339), resourceType, status, resourceType,
+		),
+	)
+}
+
+// AddResumeFailedWarning warns that resuming the wait for op on the resourceType with the given id failed with err, so
+// that the resourceType is read as it is.
+func AddResumeFailedWarning(diagnostics *diag.Diagnostics, resourceType, id string, op *PendingOperation, err error) {
+	diagnostics.AddWarning(
+		"Failed to Resume Operation",
+		fmt.Sprintf(
+			"Waiting for the %s of %s '%s', started at %s, to finish failed, so the %s is read as it is: %s",
+			op.Operation, resourceType, id, op.StartedAt.Format(time.RFC3339), resourceType, err,
+		),
+	)
+}
+
+// AddPendingOperationPlanWarning warns, when a change to the resourceType is planned while an operation on it is
+// pending, that the apply waits for the operation to finish before making the change. Creates and destroys are not
+// warned about, as there is nothing to wait for.
+func AddPendingOperationPlanWarning(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, resourceType string) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	op, diagnostics := GetPendingOperation(ctx, req.Private)
+	resp.Diagnostics.Append(diagnostics...)
+	if op == nil {
+		return
+	}
+
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.AddWarning(
+		"Apply Waits For Pending Operation",
+		fmt.Sprintf(
+			"The %s of %s '%s', started at %s, has not finished. The apply waits for it to finish before changing the %s.",
+			op.Operation, resourceType, id.ValueString(), op.StartedAt.Format(time.RFC3339), resourceType,
+		),
+	)
+}
@@ -0,0 +1,92 @@
+package coreweave_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// privateState is an in-memory coreweave.PrivateState.
+type privateState map[string][]byte
+
+func (p privateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p privateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(p, key)
+		return nil
+	}
+	p[key] = value
+	return nil
+}
+
+func TestPendingOperation(t *testing.T) {
+	t.Parallel()
+
+	private := privateState{}
+
+	op, diagnostics := coreweave.GetPendingOperation(t.Context(), private)
+	require.Empty(t, diagnostics)
+	assert.Nil(t, op, "no operation is pending initially")
+
+	before := time.Now()
+	require.Empty(t, coreweave.SetPendingOperation(t.Context(), private, "create"))
+
+	op, diagnostics = coreweave.GetPendingOperation(t.Context(), private)
+	require.Empty(t, diagnostics)
+	require.NotNil(t, op)
+	assert.Equal(t, "create", op.Operation)
+	assert.WithinDuration(t, before, op.StartedAt, time.Minute)
+
+	require.Empty(t, coreweave.ClearPendingOperation(t.Context(), private))
+	op, diagnostics = coreweave.GetPendingOperation(t.Context(), private)
+	require.Empty(t, diagnostics)
+	assert.Nil(t, op)
+	assert.Empty(t, private)
+}
+
+func TestGetPendingOperation_Undecodable(t *testing.T) {
+	t.Parallel()
+
+	op, diagnostics := coreweave.GetPendingOperation(t.Context(), privateState{"pending_operation": []byte(`"create"`)})
+	assert.Empty(t, diagnostics)
+	assert.Nil(t, op)
+}
+
+func TestAddPendingOperationWarning(t *testing.T) {
+	t.Parallel()
+
+	var diagnostics diag.Diagnostics
+	op := &coreweave.PendingOperation{Operation: "create", StartedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)}
+	coreweave.AddPendingOperationWarning(&diagnostics, "cluster", "cluster-1", op, "STATUS_CREATING")
+
+	assert.Equal(t, diag.Diagnostics{diag.NewWarningDiagnostic(
+		"Operation Still In Progress",
+		"The create of cluster 'cluster-1', started at 2026-01-02T03:04:05Z, has not finished, and the cluster is still STATUS_CREATING. The next apply that changes the cluster waits for the create to finish first.",
+	)}, diagnostics)
+}
+
+func TestPendingOperation_Remaining(t *testing.T) {
+	t.Parallel()
+
+	op := &coreweave.PendingOperation{Operation: "create", StartedAt: time.Now().Add(-10 * time.Minute)}
+	assert.InDelta(t, float64(35*time.Minute), float64(op.Remaining(45*time.Minute)), float64(time.Minute))
+	assert.Negative(t, op.Remaining(5*time.Minute), "the timeout has elapsed")
+}
+
+func TestIsInterrupted(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, coreweave.IsInterrupted(fmt.Errorf("waiting: %w", context.Canceled)))
+	assert.False(t, coreweave.IsInterrupted(context.DeadlineExceeded), "a timeout is not an interruption")
+	assert.False(t, coreweave.IsInterrupted(errors.New("cluster creation failed")))
+}
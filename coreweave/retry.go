@@ -5,9 +5,19 @@ import (
 	"crypto/tls"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"net/url"
 	"regexp"
+	"time"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+const (
+	DefaultRetryMaxAttempts int           = 10
+	DefaultRetryMinWait     time.Duration = 200 * time.Millisecond
+	DefaultRetryMaxWait     time.Duration = 5 * time.Second
 )
 
 var (
@@ -32,6 +42,30 @@ var (
 	notTrustedErrorRe = regexp.MustCompile(`certificate is not trusted`)
 )
 
+// retryClass records whether an API call may be repeated after the server might have acted on it.
+type retryClass int
+
+const (
+	// retryClassUnknown is the class of requests that were not classified, such as S3 requests, which are retried
+	// according to the base policy alone.
+	retryClassUnknown retryClass = iota
+	// retryClassSafe is the class of read-only calls.
+	retryClassSafe
+	// retryClassUnsafe is the class of mutating calls, which might be duplicated if they were repeated.
+	retryClassUnsafe
+)
+
+type retryClassContextKey struct{}
+
+func withRetryClass(ctx context.Context, class retryClass) context.Context {
+	return context.WithValue(ctx, retryClassContextKey{}, class)
+}
+
+func retryClassFrom(ctx context.Context) retryClass {
+	class, _ := ctx.Value(retryClassContextKey{}).(retryClass)
+	return class
+}
+
 func baseRetryPolicy(resp *http.Response, err error) (bool, error) {
 	if err != nil {
 		var v *url.Error
@@ -89,8 +123,13 @@ func RetryPolicy(ctx context.Context, resp *http.Response, err error) (bool, err
 			return false, ctx.Err()
 		}
 
-		// context.DeadlineExceeded is retried to handle intermittent timeouts
-		return true, ctx.Err()
+		// context.DeadlineExceeded is retried to handle intermittent timeouts, unless the server may have acted on
+		// a request that is not safe to repeat
+		return retryClassFrom(ctx) != retryClassUnsafe, ctx.Err()
+	}
+
+	if retryClassFrom(ctx) == retryClassUnsafe {
+		return unsafeRetryPolicy(resp, err)
 	}
 
 	if errors.Is(err, context.DeadlineExceeded) {
@@ -99,3 +138,50 @@ func RetryPolicy(ctx context.Context, resp *http.Response, err error) (bool, err
 
 	return baseRetryPolicy(resp, err)
 }
+
+// unsafeRetryPolicy retries a request that is not safe to repeat only when the server certainly did not act on it:
+// when no connection could be made, or when it was rejected with 429 Too Many Requests.
+func unsafeRetryPolicy(resp *http.Response, err error) (bool, error) {
+	if err != nil {
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == "dial" {
+			return true, nil
+		}
+		return false, err
+	}
+
+	return resp.StatusCode == http.StatusTooManyRequests, nil
+}
+
+// RetryConfig controls how the HTTP clients used for the CoreWeave API and S3 retry failed requests.
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of retries after the initial request; 0 disables retries.
+	MaxAttempts int
+	// MinWait and MaxWait bound the jittered exponential back-off between retries.
+	MinWait time.Duration
+	MaxWait time.Duration
+}
+
+// DefaultRetryConfig returns the retry configuration used when none is provided.
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxAttempts: DefaultRetryMaxAttempts,
+		MinWait:     DefaultRetryMinWait,
+		MaxWait:     DefaultRetryMaxWait,
+	}
+}
+
+// newRetryableClient returns a retryablehttp client configured according to cfg.
+func newRetryableClient(cfg RetryConfig) *retryablehttp.Client {
+	rc := retryablehttp.NewClient()
+	rc.RetryMax = cfg.MaxAttempts
+	rc.RetryWaitMin = cfg.MinWait
+	rc.RetryWaitMax = cfg.MaxWait
+	// Jittered exponential back-off (min*2^n) with capping.
+	rc.Backoff = retryablehttp.DefaultBackoff
+	// Retry transport errors, 429 and 5xx, but mutating API calls only if they are safe to repeat; see
+	// IdempotencyInterceptor.
+	rc.CheckRetry = RetryPolicy
+
+	return rc
+}
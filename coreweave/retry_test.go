@@ -0,0 +1,159 @@
+package coreweave
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	networkingv1beta1 "buf.build/gen/go/coreweave/networking/protocolbuffers/go/coreweave/networking/v1beta1"
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicy_Classification(t *testing.T) {
+	t.Parallel()
+
+	dialErr := &url.Error{Op: "Post", URL: "https://api.coreweave.com", Err: &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}}
+	resetErr := &url.Error{Op: "Post", URL: "https://api.coreweave.com", Err: &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}}
+	status := func(code int) *http.Response {
+		return &http.Response{StatusCode: code, Status: http.StatusText(code)}
+	}
+
+	tests := []struct {
+		name  string
+		class retryClass
+		resp  *http.Response
+		err   error
+		want  bool
+	}{
+		{name: "unclassified server error", class: retryClassUnknown, resp: status(http.StatusBadGateway), want: true},
+		{name: "unclassified connection reset", class: retryClassUnknown, err: resetErr, want: true},
+		{name: "safe server error", class: retryClassSafe, resp: status(http.StatusServiceUnavailable), want: true},
+		{name: "safe connection reset", class: retryClassSafe, err: resetErr, want: true},
+		{name: "safe timeout", class: retryClassSafe, err: context.DeadlineExceeded, want: true},
+		{name: "safe success", class: retryClassSafe, resp: status(http.StatusOK), want: false},
+		{name: "unsafe server error", class: retryClassUnsafe, resp: status(http.StatusBadGateway), want: false},
+		{name: "unsafe connection reset", class: retryClassUnsafe, err: resetErr, want: false},
+		{name: "unsafe timeout", class: retryClassUnsafe, err: context.DeadlineExceeded, want: false},
+		{name: "unsafe connection refused", class: retryClassUnsafe, err: dialErr, want: true},
+		{name: "unsafe too many requests", class: retryClassUnsafe, resp: status(http.StatusTooManyRequests), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, _ := RetryPolicy(withRetryClass(t.Context(), tt.class), tt.resp, tt.err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRetryPolicy_ExpiredContext(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithDeadline(t.Context(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	retry, err := RetryPolicy(withRetryClass(ctx, retryClassSafe), nil, context.DeadlineExceeded)
+	assert.True(t, retry)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	retry, err = RetryPolicy(withRetryClass(ctx, retryClassUnsafe), nil, context.DeadlineExceeded)
+	assert.False(t, retry)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+// flakyAPI serves the fake API, but fails the first attempt of every call with status. A 5xx status is returned after
+// the fake has handled the call, as if the response had been lost on the way back; other statuses reject the call.
+type flakyAPI struct {
+	handler http.Handler
+	status  int
+
+	mu       sync.Mutex
+	attempts map[string][]string // idempotency keys of the attempts, by procedure
+}
+
+func (f *flakyAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	procedure := r.URL.Path
+	f.attempts[procedure] = append(f.attempts[procedure], r.Header.Get(IdempotencyKeyHeader))
+	first := len(f.attempts[procedure]) == 1
+	f.mu.Unlock()
+
+	if first {
+		if f.status >= http.StatusInternalServerError {
+			f.handler.ServeHTTP(httptest.NewRecorder(), r)
+		}
+		w.WriteHeader(f.status)
+		return
+	}
+	f.handler.ServeHTTP(w, r)
+}
+
+func (f *flakyAPI) keys(procedure string) []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.attempts[procedure]
+}
+
+func newFlakyClient(t *testing.T, status int) (*Client, *flakyAPI) {
+	t.Helper()
+
+	api := &flakyAPI{handler: fake.New(fake.WithDelay(0)).Handler(), status: status, attempts: map[string][]string{}}
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	retry := RetryConfig{MaxAttempts: 2, MinWait: time.Millisecond, MaxWait: time.Millisecond}
+	client, err := NewClient(server.URL, server.URL, 10*time.Second, retry, TransportConfig{}, bearerTokenInterceptor(testCassetteToken))
+	require.NoError(t, err)
+	return client, api
+}
+
+func TestClient_IdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	const createVPC = "/coreweave.networking.v1beta1.VPCService/CreateVPC"
+
+	t.Run("mutating calls are not retried once the API may have acted on them", func(t *testing.T) {
+		t.Parallel()
+
+		client, api := newFlakyClient(t, http.StatusBadGateway)
+		ctx := WithIdempotencyKey(t.Context(), "create-vpc-1")
+		_, err := client.CreateVPC(ctx, connect.NewRequest(&networkingv1beta1.CreateVPCRequest{Name: "lost", Zone: "US-EAST-04A"}))
+		require.Error(t, err)
+		assert.Equal(t, []string{"create-vpc-1"}, api.keys(createVPC))
+
+		_, err = client.DeleteVPC(t.Context(), connect.NewRequest(&networkingv1beta1.DeleteVPCRequest{Id: "missing"}))
+		require.Error(t, err)
+		deleteKeys := api.keys("/coreweave.networking.v1beta1.VPCService/DeleteVPC")
+		require.Len(t, deleteKeys, 1)
+		assert.NotEmpty(t, deleteKeys[0], "a key is generated for mutating calls")
+
+		list, err := client.ListVPCs(t.Context(), connect.NewRequest(&networkingv1beta1.ListVPCsRequest{}))
+		require.NoError(t, err)
+		require.Len(t, list.Msg.GetItems(), 1, "the VPC was created once")
+		assert.Equal(t, []string{"", ""}, api.keys("/coreweave.networking.v1beta1.VPCService/ListVPCs"), "read-only calls are retried and carry no key")
+	})
+
+	t.Run("rejected mutating calls are retried with the same key", func(t *testing.T) {
+		t.Parallel()
+
+		client, api := newFlakyClient(t, http.StatusTooManyRequests)
+		created, err := client.CreateVPC(t.Context(), connect.NewRequest(&networkingv1beta1.CreateVPCRequest{Name: "throttled", Zone: "US-EAST-04A"}))
+		require.NoError(t, err)
+		assert.Equal(t, "throttled", created.Msg.GetVpc().GetName())
+		keys := api.keys(createVPC)
+		require.Len(t, keys, 2)
+		assert.NotEmpty(t, keys[0])
+		assert.Equal(t, keys[0], keys[1], "retries reuse the key")
+	})
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"slices"
 	"sync"
 	"time"
 
@@ -14,148 +16,399 @@ import (
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/hashicorp/go-cleanhttp"
-	retryablehttp "github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/singleflight"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 var (
-	// We use global variables here because the ConfigureProvider RPC is called on every Create/Update/Delete
+	// We use a global pool here because the ConfigureProvider RPC is called on every Create/Update/Delete
 	// operation within a plan/apply which leads to the provider rebuilding a fresh client on each Terraform operation.
-	// Using global variables allows the s3 client to be persisted across Terraform operations
+	// Using a global pool allows the s3 clients to be persisted across Terraform operations
 	// and prevents us from generating excess access keys
-	s3Mu              sync.Mutex
-	singletonS3Client *s3.Client
-	s3AccessKeyInfo   *cwobjectv1.CreateAccessKeyFromJWTResponse
+	s3PoolsMu sync.Mutex
+	s3Pools   = map[s3PoolKey]*s3ClientPool{}
 )
 
+// S3Config customizes how the provider talks to the S3 API, e.g. to run against MinIO or another S3-compatible server
+// in place of CoreWeave Object Storage.
+type S3Config struct {
+	// AccessKeyID and SecretAccessKey are static credentials used instead of access keys minted with the API token.
+	// Static credentials are never refreshed.
+	AccessKeyID     string
+	SecretAccessKey string
+	// UsePathStyle addresses buckets as a path of the endpoint, rather than as a subdomain of it.
+	UsePathStyle bool
+	// SkipCredentialsValidation skips waiting for a ListBuckets call to succeed before an access key is used.
+	SkipCredentialsValidation bool
+}
+
+// HasStaticCredentials reports whether static credentials are configured.
+func (c S3Config) HasStaticCredentials() bool {
+	return c.AccessKeyID != "" || c.SecretAccessKey != ""
+}
+
+// s3PoolKey identifies the credentials an s3ClientPool mints access keys with and the settings its clients are built
+// with, so that provider configurations that differ in either never share clients.
+type s3PoolKey struct {
+	endpoint string
+	token    string
+	s3       S3Config
+}
+
+// s3ClientPool holds one S3 client per zone. All clients share a single access key, which is minted at most once at a
+// time no matter how many callers need it. The clients read the key from the pool on every request, so a refreshed key
+// takes effect for clients that callers are already holding.
+type s3ClientPool struct {
+	mu      sync.Mutex
+	key     *cwobjectv1.CreateAccessKeyFromJWTResponse
+	clients map[string]*s3.Client
+	// static is set if key holds static credentials, which do not expire and cannot be refreshed
+	static bool
+
+	keyGroup singleflight.Group
+}
+
 const (
 	errAccessDenied string = "AccessDenied"
+
+	// defaultS3Zone is the region configured on S3 clients that are not bound to a zone.
+	defaultS3Zone string = "notempty"
+
+	// DefaultAccessKeyDuration is the lifetime of the access keys minted for the provider's own S3 client.
+	DefaultAccessKeyDuration = 15 * time.Minute
+
+	// accessKeyExpiryWindow is how long before it expires an access key is replaced.
+	accessKeyExpiryWindow = 5 * time.Minute
 )
 
+// credentialErrorCodes are the S3 error codes returned when an access key has expired, has been revoked, or is otherwise
+// no longer accepted. AccessDenied is not one of them, as it is mostly returned when a bucket policy or organization
+// access policy denies the operation, which a new key does not change.
+var credentialErrorCodes = []string{"InvalidAccessKeyId", "SignatureDoesNotMatch", "ExpiredToken"}
+
 func (c *Client) s3HttpClient() *http.Client {
-	rc := retryablehttp.NewClient()
+	retry := DefaultRetryConfig()
+	if c.retry != nil {
+		retry = *c.retry
+	}
+
+	rc := newRetryableClient(retry)
 	rc.HTTPClient.Timeout = 30 * time.Second
 	// cleanhttp.DefaultTransport disables keep-alives & idle connections
 	// this helps us avoid S3 DNS caching, which can make creating/deleting buckets inconsistent
-	rc.HTTPClient.Transport = cleanhttp.DefaultTransport()
-	rc.RetryMax = 10
-	rc.RetryWaitMin = 200 * time.Millisecond
-	rc.RetryWaitMax = 5 * time.Second
-	// Jittered exponential back-off (min*2^n) with capping.
-	rc.Backoff = retryablehttp.DefaultBackoff
-	// Treat only idempotent verbs + 502/503/504 + transport errors as retryable.
-	rc.CheckRetry = RetryPolicy
+	transport := cleanhttp.DefaultTransport()
+	c.transport.apply(transport)
+	rc.HTTPClient.Transport = traceTransport(transport)
 
-	return rc.StandardClient()
+	client := rc.StandardClient()
+	c.transport.wrap(client)
+	return client
 }
 
-func (c *Client) createS3Client(ctx context.Context, zone string) (*s3.Client, *cwobjectv1.CreateAccessKeyFromJWTResponse, error) {
+// S3Endpoint returns the endpoint of the CoreWeave Object Storage S3 API.
+func (c *Client) S3Endpoint() string {
+	return c.s3Endpoint
+}
+
+// CreateAccessKey mints an Object Storage access key for the identity of the provider's API token, valid for duration,
+// and waits until the key can be used. The duration is truncated to whole seconds.
+func (c *Client) CreateAccessKey(ctx context.Context, duration time.Duration) (*cwobjectv1.CreateAccessKeyFromJWTResponse, error) {
+	key, err := c.mintAccessKey(ctx, duration)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.validateAccessKey(ctx, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func (c *Client) mintAccessKey(ctx context.Context, duration time.Duration) (*cwobjectv1.CreateAccessKeyFromJWTResponse, error) {
+	seconds := duration / time.Second
+	if seconds < 1 || seconds > math.MaxUint32 {
+		return nil, fmt.Errorf("access key duration must be between 1s and %v, got %v", time.Duration(math.MaxUint32)*time.Second, duration)
+	}
+
 	resp, err := c.CreateAccessKeyFromJWT(ctx, connect.NewRequest(&cwobjectv1.CreateAccessKeyFromJWTRequest{
-		DurationSeconds: wrapperspb.UInt32(60 * 15), // 15 minutes
+		DurationSeconds: wrapperspb.UInt32(uint32(seconds)),
 	}))
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
+	return resp.Msg, nil
+}
+
+// accessKeyCredentials returns a credentials provider for a single access key.
+func accessKeyCredentials(key *cwobjectv1.CreateAccessKeyFromJWTResponse) aws.CredentialsProvider {
+	return aws.NewCredentialsCache(credentials.NewStaticCredentialsProvider(
+		key.AccessKeyId,
+		key.SecretKey,
+		"",
+	))
+}
 
-	httpClient := c.s3HttpClient()
-	s3Client := s3.New(s3.Options{
-		BaseEndpoint: aws.String(c.s3Endpoint),
-		Credentials: aws.NewCredentialsCache(credentials.NewStaticCredentialsProvider(
-			resp.Msg.AccessKeyId,
-			resp.Msg.SecretKey,
-			"",
-		)),
-		HTTPClient:                 httpClient,
+func (c *Client) newS3Client(zone string, credentials aws.CredentialsProvider, optFns ...func(*s3.Options)) *s3.Client {
+	return s3.New(s3.Options{
+		BaseEndpoint:               aws.String(c.s3Endpoint),
+		Credentials:                credentials,
+		HTTPClient:                 c.s3HttpClient(),
 		Region:                     zone, // must be non-empty and a valid DNS subdomain
 		RequestChecksumCalculation: aws.RequestChecksumCalculationWhenSupported,
 		ResponseChecksumValidation: aws.ResponseChecksumValidationWhenSupported,
-		UsePathStyle:               false,
+		UsePathStyle:               c.S3.UsePathStyle,
+	}, optFns...)
+}
+
+func (c *Client) createS3Client(ctx context.Context, zone string) (*s3.Client, *cwobjectv1.CreateAccessKeyFromJWTResponse, error) {
+	key, err := c.mintAccessKey(ctx, DefaultAccessKeyDuration)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return c.newS3Client(zone, accessKeyCredentials(key)), key, nil
+}
+
+// waitForAccessKey waits until client's access key is accepted - we've observed that it can take several seconds for
+// access keys to propagate.
+func waitForAccessKey(ctx context.Context, client *s3.Client) error {
+	return PollUntil("s3 access key validation", ctx, 1*time.Second, 1*time.Minute, func(ctx context.Context) (bool, error) {
+		_, err := client.ListBuckets(ctx, &s3.ListBucketsInput{})
+		// retry only on AccessDenied errors
+		if err != nil {
+			var apiErr smithy.APIError
+			if errors.As(err, &apiErr) && apiErr.ErrorCode() == errAccessDenied {
+				return false, nil
+			}
+		}
+
+		return err == nil, err
 	})
-	return s3Client, resp.Msg, nil
 }
 
-func (c *Client) S3Client(ctx context.Context, zone string) (*s3.Client, error) {
-	s3Mu.Lock()
-	defer s3Mu.Unlock()
+// validateAccessKey waits until key is accepted by S3, unless validation is disabled.
+func (c *Client) validateAccessKey(ctx context.Context, key *cwobjectv1.CreateAccessKeyFromJWTResponse) error {
+	if c.S3.SkipCredentialsValidation {
+		tflog.Debug(ctx, "skipping s3 access key validation")
+		return nil
+	}
+	return waitForAccessKey(ctx, c.newS3Client(defaultS3Zone, accessKeyCredentials(key)))
+}
+
+// isCredentialError reports whether err is an S3 error caused by the access key the request was signed with. An
+// AccessDenied error only counts if keyExpiring is set, i.e. the key was within its expiry window, as S3 may deny a
+// request signed with a key that has just expired rather than report it as expired.
+func isCredentialError(err error, keyExpiring bool) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return slices.Contains(credentialErrorCodes, apiErr.ErrorCode()) || (keyExpiring && apiErr.ErrorCode() == errAccessDenied)
+}
+
+// s3ClientPool returns the pool of S3 clients for the client's endpoint and token.
+func (c *Client) s3ClientPool() *s3ClientPool {
+	s3PoolsMu.Lock()
+	defer s3PoolsMu.Unlock()
 
+	key := s3PoolKey{endpoint: c.s3Endpoint, token: c.Token, s3: c.S3}
+	pool, ok := s3Pools[key]
+	if !ok {
+		pool = &s3ClientPool{clients: map[string]*s3.Client{}}
+		if c.S3.HasStaticCredentials() {
+			pool.key = &cwobjectv1.CreateAccessKeyFromJWTResponse{
+				AccessKeyId: c.S3.AccessKeyID,
+				SecretKey:   c.S3.SecretAccessKey,
+			}
+			pool.static = true
+		}
+		s3Pools[key] = pool
+	}
+	return pool
+}
+
+// S3Client returns an S3 client for zone, creating it if needed. Clients are cached per zone and share an access key,
+// which is refreshed when it is within 5 minutes of expiring, or when S3 rejects it, in which case the rejected
+// operation is retried once. If static credentials are configured, they are used as-is.
+func (c *Client) S3Client(ctx context.Context, zone string) (*s3.Client, error) {
 	// We use 'notempty' as the zone here because it doesn't actually matter what region is configured for cwobject.com
 	// it only matters that it's not empty & a valid DNS subdomain
 	s3Zone := zone
 	if zone == "" {
-		s3Zone = "notempty"
+		s3Zone = defaultS3Zone
 	}
 
-	if s3AccessKeyInfo == nil || singletonS3Client == nil {
-		tflog.Info(ctx, "creating new s3 client because one does not exist")
-		client, keyInfo, err := c.createS3Client(ctx, s3Zone)
-		if err != nil {
-			return nil, err
-		}
+	pool := c.s3ClientPool()
+	if client, ok := pool.client(c, s3Zone, false); ok {
+		tflog.Debug(ctx, "fetched cached s3 client", map[string]any{"zone": s3Zone})
+		return client, nil
+	}
 
-		// we need to ensure the access keys are valid before we overwrite the client - we've observed that
-		// it can take several seconds for access keys to propagate
-		if err := PollUntil("s3 access key validation", ctx, 1*time.Second, 1*time.Minute, func(ctx context.Context) (bool, error) {
-			_, err := client.ListBuckets(ctx, &s3.ListBucketsInput{})
-			// retry only on AccessDenied errors
-			if err != nil {
-				var apiErr smithy.APIError
-				if errors.As(err, &apiErr) && apiErr.ErrorCode() == errAccessDenied {
-					return false, nil
-				}
-			}
+	if err := pool.refreshKey(ctx, c, ""); err != nil {
+		return nil, err
+	}
 
-			return err == nil, err
-		}); err != nil {
-			return nil, err
+	// use the refreshed key even if it is already close to expiring, rather than minting keys in a loop
+	client, _ := pool.client(c, s3Zone, true)
+	return client, nil
+}
+
+// client returns the pool's client for zone, building it from the shared access key if there is none yet. Unless
+// allowExpiring is set, it returns false if the access key is missing or expires within the next 5 minutes.
+func (p *s3ClientPool) client(c *Client, zone string, allowExpiring bool) (*s3.Client, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.key == nil || (!p.static && !allowExpiring && p.keyExpiring()) {
+		return nil, false
+	}
+
+	client, ok := p.clients[zone]
+	if !ok {
+		if p.static {
+			client = c.newS3Client(zone, p)
+		} else {
+			client = c.newS3Client(zone, p, func(o *s3.Options) {
+				o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+					return stack.Finalize.Insert(&refreshOnCredentialError{pool: p, client: c}, "Retry", middleware.Before)
+				})
+			})
 		}
+		p.clients[zone] = client
+	}
+	return client, true
+}
 
-		singletonS3Client = client
-		s3AccessKeyInfo = keyInfo
-		tflog.Info(ctx, "created new s3 client")
-		return client, nil
+// keyExpiring reports whether the pool's access key expires within accessKeyExpiryWindow. The caller must hold p.mu.
+func (p *s3ClientPool) keyExpiring() bool {
+	return time.Until(p.key.GetExpiry().AsTime()) <= accessKeyExpiryWindow
+}
+
+// accessKeyID returns the ID of the pool's current access key, or an empty string if there is none.
+func (p *s3ClientPool) accessKeyID() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.key.GetAccessKeyId()
+}
+
+// accessKey returns the ID of the pool's current access key, and whether it expires within accessKeyExpiryWindow.
+func (p *s3ClientPool) accessKey() (string, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.key.GetAccessKeyId(), p.key != nil && p.keyExpiring()
+}
+
+// Retrieve implements aws.CredentialsProvider with the pool's current access key.
+func (p *s3ClientPool) Retrieve(context.Context) (aws.Credentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.key == nil {
+		return aws.Credentials{}, errors.New("no s3 access key has been created")
 	}
+	return aws.Credentials{
+		AccessKeyID:     p.key.AccessKeyId,
+		SecretAccessKey: p.key.SecretKey,
+		CanExpire:       p.key.Expiry != nil,
+		Expires:         p.key.Expiry.AsTime(),
+	}, nil
+}
+
+// refreshKey mints a new access key, validates it and makes the pool's clients use it. If staleKeyID is set, the key is
+// only replaced if it is still the one with that ID, so that callers that saw the same key rejected refresh it once.
+// Concurrent callers share a single refresh, which is not canceled if one of them gives up waiting for it.
+func (p *s3ClientPool) refreshKey(ctx context.Context, c *Client, staleKeyID string) error {
+	result := p.keyGroup.DoChan("access-key", func() (any, error) {
+		ctx := context.WithoutCancel(ctx)
+		if staleKeyID != "" && p.accessKeyID() != staleKeyID {
+			tflog.Debug(ctx, "s3 access key was already replaced")
+			return nil, nil
+		}
+		tflog.Info(ctx, "creating new s3 access key")
 
-	// expiry is within 5 minutes from now (or already expired), refresh the client
-	if time.Until(s3AccessKeyInfo.Expiry.AsTime()) <= 5*time.Minute {
-		tflog.Info(ctx, "refreshing s3 client because keys expire within the next 5 minutes or have already expired")
-		client, keyInfo, err := c.createS3Client(ctx, s3Zone)
+		key, err := c.mintAccessKey(ctx, DefaultAccessKeyDuration)
 		if err != nil {
 			return nil, err
 		}
 
-		// we need to ensure the access keys are valid before we overwrite the client - we've observed that
-		// it can take several seconds for access keys to propagate
-		if err := PollUntil("s3 access key validation", ctx, 1*time.Second, 1*time.Minute, func(ctx context.Context) (bool, error) {
-			_, err := client.ListBuckets(ctx, &s3.ListBucketsInput{})
-			// retry only on AccessDenied errors
-			if err != nil {
-				var apiErr smithy.APIError
-				if errors.As(err, &apiErr) && apiErr.ErrorCode() == errAccessDenied {
-					return false, nil
-				}
-			}
-			return err == nil, err
-		}); err != nil {
+		// we need to ensure the access key is valid before the clients use it
+		if err := c.validateAccessKey(ctx, key); err != nil {
 			return nil, err
 		}
 
-		singletonS3Client = client
-		s3AccessKeyInfo = keyInfo
-		tflog.Info(ctx, "refreshed s3 client")
-		return client, nil
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		p.key = key
+		tflog.Info(ctx, "created new s3 access key")
+		return nil, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case r := <-result:
+		return r.Err
 	}
+}
 
-	tflog.Info(ctx, "fetched cached s3 client")
-	// otherwise use the already cached client
-	return singletonS3Client, nil
+// refreshOnCredentialError is an S3 middleware that refreshes the pool's access key and retries the operation once
+// when S3 rejects the key, e.g. because it was revoked or expired during a long-running apply. It wraps the SDK's own
+// retries, so the retried operation gets a full set of attempts with the new key. Operations denied by a policy are not
+// retried, so that they do not mint a key each.
+type refreshOnCredentialError struct {
+	pool   *s3ClientPool
+	client *Client
+}
+
+func (*refreshOnCredentialError) ID() string { return "RefreshOnCredentialError" }
+
+func (m *refreshOnCredentialError) HandleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (
+	middleware.FinalizeOutput, middleware.Metadata, error,
+) {
+	keyID, keyExpiring := m.pool.accessKey()
+	out, metadata, err := next.HandleFinalize(ctx, in)
+	if !isCredentialError(err, keyExpiring) {
+		return out, metadata, err
+	}
+
+	tflog.Warn(ctx, "s3 rejected the access key, refreshing it and retrying", map[string]any{"error": err.Error()})
+	if refreshErr := m.pool.refreshKey(ctx, m.client, keyID); refreshErr != nil {
+		return out, metadata, errors.Join(err, fmt.Errorf("failed to refresh s3 access key: %w", refreshErr))
+	}
+
+	if req, ok := in.Request.(*smithyhttp.Request); ok {
+		if rewindErr := req.RewindStream(); rewindErr != nil {
+			tflog.Warn(ctx, "cannot retry s3 request with refreshed access key", map[string]any{"error": rewindErr.Error()})
+			return out, metadata, err
+		}
+	}
+	return next.HandleFinalize(ctx, in)
 }
 
 // PollUntil runs check(ctx) every interval until it returns (true, nil),
 // or else returns the first non‐nil error, or a timeout error.
-func PollUntil(operation string, parentCtx context.Context, interval, timeout time.Duration, check func(ctx context.Context) (bool, error)) error {
-	ctx, cancel := context.WithTimeout(parentCtx, timeout)
+func PollUntil(operation string, parentCtx context.Context, interval, timeout time.Duration, check func(ctx context.Context) (bool, error)) (err error) {
+	ctx, span := tracer().Start(parentCtx, "poll "+operation, trace.WithAttributes(
+		attrOperation.String(operation),
+		attrTimeout.String(timeout.String()),
+	))
+	attempts := 0
+	defer func() {
+		span.SetAttributes(attrAttempts.Int(attempts))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	ticker := time.NewTicker(interval)
@@ -166,6 +419,7 @@ func PollUntil(operation string, parentCtx context.Context, interval, timeout ti
 		case <-ctx.Done():
 			return fmt.Errorf("timed out polling for %s after %v: %w", operation, timeout, ctx.Err())
 		case <-ticker.C:
+			attempts++
 			ok, err := check(ctx)
 			if err != nil {
 				return err
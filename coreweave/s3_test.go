@@ -8,12 +8,16 @@ import (
 	"crypto/x509/pkix"
 	"encoding/pem"
 	"fmt"
+	"io"
 	"math/big"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"reflect"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -21,7 +25,12 @@ import (
 	cwobjectv1 "buf.build/gen/go/coreweave/cwobject/protocolbuffers/go/cwobject/v1"
 	"connectrpc.com/connect"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -302,3 +311,334 @@ endpoint_url = https://wrong.example.test
 		})
 	}
 }
+
+func TestS3HttpClient_RetryConfig(t *testing.T) {
+	tests := map[string]struct {
+		retry *RetryConfig
+		want  RetryConfig
+	}{
+		"defaults when unset": {
+			retry: nil,
+			want:  DefaultRetryConfig(),
+		},
+		"configured": {
+			retry: &RetryConfig{MaxAttempts: 3, MinWait: time.Second, MaxWait: 30 * time.Second},
+			want:  RetryConfig{MaxAttempts: 3, MinWait: time.Second, MaxWait: 30 * time.Second},
+		},
+		"retries disabled": {
+			retry: &RetryConfig{MaxAttempts: 0, MinWait: DefaultRetryMinWait, MaxWait: DefaultRetryMaxWait},
+			want:  RetryConfig{MaxAttempts: 0, MinWait: DefaultRetryMinWait, MaxWait: DefaultRetryMaxWait},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &Client{retry: tt.retry}
+
+			transport, ok := client.s3HttpClient().Transport.(*retryablehttp.RoundTripper)
+			if !ok {
+				t.Fatalf("HTTP transport type = %T, want *retryablehttp.RoundTripper", client.s3HttpClient().Transport)
+			}
+
+			got := RetryConfig{
+				MaxAttempts: transport.Client.RetryMax,
+				MinWait:     transport.Client.RetryWaitMin,
+				MaxWait:     transport.Client.RetryWaitMax,
+			}
+			if got != tt.want {
+				t.Errorf("retry config = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// countingCWObjectClientStub mints access keys that expire after expiresIn, counting how many it has minted.
+type countingCWObjectClientStub struct {
+	cwobjectv1connect.CWObjectClient
+
+	expiresIn time.Duration
+	minted    atomic.Int32
+}
+
+func (s *countingCWObjectClientStub) CreateAccessKeyFromJWT(
+	_ context.Context,
+	_ *connect.Request[cwobjectv1.CreateAccessKeyFromJWTRequest],
+) (*connect.Response[cwobjectv1.CreateAccessKeyFromJWTResponse], error) {
+	n := s.minted.Add(1)
+	// give concurrent callers a chance to pile up behind the first one
+	time.Sleep(50 * time.Millisecond)
+
+	return connect.NewResponse(&cwobjectv1.CreateAccessKeyFromJWTResponse{
+		AccessKeyId: fmt.Sprintf("%s-%d", testS3AccessKey, n),
+		SecretKey:   testS3SecretKey,
+		Expiry:      timestamppb.New(time.Now().Add(s.expiresIn)),
+	}), nil
+}
+
+// newListBucketsServer serves an empty ListBuckets response, so that every access key validates immediately.
+func newListBucketsServer(t *testing.T) string {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = w.Write([]byte(`<ListAllMyBucketsResult><Buckets></Buckets></ListAllMyBucketsResult>`))
+	}))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestS3Client_PerZonePool(t *testing.T) {
+	stub := &countingCWObjectClientStub{expiresIn: time.Hour}
+	client := &Client{
+		CWObjectClient: stub,
+		s3Endpoint:     newListBucketsServer(t),
+		Token:          t.Name(),
+	}
+
+	zones := []string{"US-TEST-01A", "US-TEST-02A", ""}
+	clients := make([][]*s3.Client, len(zones))
+
+	var wg sync.WaitGroup
+	for i, zone := range zones {
+		clients[i] = make([]*s3.Client, 5)
+		for j := range clients[i] {
+			wg.Go(func() {
+				s3Client, err := client.S3Client(t.Context(), zone)
+				require.NoError(t, err)
+				clients[i][j] = s3Client
+			})
+		}
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), stub.minted.Load(), "concurrent callers should share a single access key")
+
+	for i, zone := range zones {
+		wantRegion := zone
+		if zone == "" {
+			wantRegion = defaultS3Zone
+		}
+		for _, s3Client := range clients[i] {
+			assert.Same(t, clients[i][0], s3Client, "zone %q should have a single client", zone)
+			assert.Equal(t, wantRegion, s3Client.Options().Region)
+		}
+	}
+	assert.NotSame(t, clients[0][0], clients[1][0], "zones should not share a client")
+}
+
+func TestS3Client_RefreshesExpiringKey(t *testing.T) {
+	stub := &countingCWObjectClientStub{expiresIn: time.Minute}
+	client := &Client{
+		CWObjectClient: stub,
+		s3Endpoint:     newListBucketsServer(t),
+		Token:          t.Name(),
+	}
+
+	first, err := client.S3Client(t.Context(), "US-TEST-01A")
+	require.NoError(t, err)
+	second, err := client.S3Client(t.Context(), "US-TEST-01A")
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(2), stub.minted.Load(), "a key expiring within 5 minutes should be replaced")
+	assert.Same(t, first, second, "clients should be kept across key refreshes")
+
+	credentials, err := first.Options().Credentials.Retrieve(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, testS3AccessKey+"-2", credentials.AccessKeyID, "existing clients should use the refreshed key")
+}
+
+func TestS3Client_SeparatePoolsPerToken(t *testing.T) {
+	endpoint := newListBucketsServer(t)
+	stub := &countingCWObjectClientStub{expiresIn: time.Hour}
+
+	a := &Client{CWObjectClient: stub, s3Endpoint: endpoint, Token: t.Name() + "-a"}
+	b := &Client{CWObjectClient: stub, s3Endpoint: endpoint, Token: t.Name() + "-b"}
+
+	clientA, err := a.S3Client(t.Context(), "US-TEST-01A")
+	require.NoError(t, err)
+	clientB, err := b.S3Client(t.Context(), "US-TEST-01A")
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(2), stub.minted.Load())
+	assert.NotSame(t, clientA, clientB)
+}
+
+// newRevokingS3Server serves ListBuckets and PutBucketTagging, answering the error code for tagging requests signed with
+// an access key that is reported as revoked, so that refreshed keys always validate. It returns the endpoint and the tagging request bodies it accepted.
+func newRevokingS3Server(t *testing.T, code string, revoked func(accessKeyID string) bool) (string, *[]string) {
+	t.Helper()
+
+	var (
+		mu       sync.Mutex
+		accepted []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Authorization: AWS4-HMAC-SHA256 Credential=<access key id>/<date>/<region>/s3/aws4_request, ...
+		credential, _, _ := strings.Cut(strings.TrimPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential="), "/")
+		w.Header().Set("Content-Type", "application/xml")
+		if r.URL.Query().Has("tagging") {
+			if revoked(credential) {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = fmt.Fprintf(w, `<Error><Code>%s</Code><Message>Access Denied</Message></Error>`, code)
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			mu.Lock()
+			accepted = append(accepted, string(body))
+			mu.Unlock()
+			return
+		}
+		_, _ = w.Write([]byte(`<ListAllMyBucketsResult><Buckets></Buckets></ListAllMyBucketsResult>`))
+	}))
+	t.Cleanup(server.Close)
+	return server.URL, &accepted
+}
+
+func putTestBucketTagging(ctx context.Context, client *s3.Client) error {
+	_, err := client.PutBucketTagging(ctx, &s3.PutBucketTaggingInput{
+		Bucket: aws.String("test-bucket"),
+		Tagging: &s3types.Tagging{
+			TagSet: []s3types.Tag{{Key: aws.String("env"), Value: aws.String("test")}},
+		},
+	})
+	return err
+}
+
+func TestS3Client_RefreshesRejectedKey(t *testing.T) {
+	var revokedKey atomic.Value
+	revokedKey.Store("")
+	endpoint, accepted := newRevokingS3Server(t, "InvalidAccessKeyId", func(accessKeyID string) bool {
+		return accessKeyID == revokedKey.Load()
+	})
+
+	stub := &countingCWObjectClientStub{expiresIn: time.Hour}
+	client := &Client{CWObjectClient: stub, s3Endpoint: endpoint, Token: t.Name()}
+
+	s3Client, err := client.S3Client(t.Context(), "US-TEST-01A")
+	require.NoError(t, err)
+
+	// revoke the key the client was created with, as if it was revoked mid-apply
+	revokedKey.Store(testS3AccessKey + "-1")
+
+	require.NoError(t, putTestBucketTagging(t.Context(), s3Client))
+	assert.Equal(t, int32(2), stub.minted.Load(), "the rejected key should be replaced once")
+	require.Len(t, *accepted, 1)
+	assert.Contains(t, (*accepted)[0], "<Key>env</Key>", "the retried request should carry the full body")
+
+	require.NoError(t, putTestBucketTagging(t.Context(), s3Client))
+	assert.Equal(t, int32(2), stub.minted.Load(), "later requests should use the refreshed key")
+}
+
+func TestS3Client_RetriesRejectedKeyOnce(t *testing.T) {
+	endpoint, accepted := newRevokingS3Server(t, "InvalidAccessKeyId", func(string) bool { return true })
+
+	stub := &countingCWObjectClientStub{expiresIn: time.Hour}
+	client := &Client{CWObjectClient: stub, s3Endpoint: endpoint, Token: t.Name()}
+
+	s3Client, err := client.S3Client(t.Context(), "US-TEST-01A")
+	require.NoError(t, err)
+
+	err = putTestBucketTagging(t.Context(), s3Client)
+	require.Error(t, err)
+	assert.True(t, isCredentialError(err, false))
+	assert.Equal(t, int32(2), stub.minted.Load(), "the key should be refreshed only once per operation")
+	assert.Empty(t, *accepted)
+}
+
+func TestS3Client_AccessDenied(t *testing.T) {
+	tests := []struct {
+		name       string
+		expiresIn  time.Duration
+		wantMinted int32
+	}{
+		{
+			name:       "a policy denial does not refresh the key",
+			expiresIn:  time.Hour,
+			wantMinted: 1,
+		},
+		{
+			name:       "a denial of a key within its expiry window refreshes it",
+			expiresIn:  time.Minute,
+			wantMinted: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// only the first key is denied, as if it had expired
+			endpoint, accepted := newRevokingS3Server(t, errAccessDenied, func(accessKeyID string) bool {
+				return accessKeyID == testS3AccessKey+"-1"
+			})
+
+			stub := &countingCWObjectClientStub{expiresIn: tt.expiresIn}
+			client := &Client{CWObjectClient: stub, s3Endpoint: endpoint, Token: t.Name()}
+
+			s3Client, err := client.S3Client(t.Context(), "US-TEST-01A")
+			require.NoError(t, err)
+
+			err = putTestBucketTagging(t.Context(), s3Client)
+			assert.Equal(t, tt.wantMinted, stub.minted.Load())
+			if tt.wantMinted == 1 {
+				require.Error(t, err)
+				assert.Empty(t, *accepted)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, *accepted, 1)
+		})
+	}
+}
+
+func TestS3Client_StaticCredentials(t *testing.T) {
+	endpoint, accepted := newRevokingS3Server(t, errAccessDenied, func(accessKeyID string) bool {
+		return accessKeyID != "minio-access-key"
+	})
+
+	stub := &countingCWObjectClientStub{expiresIn: time.Hour}
+	client := &Client{
+		CWObjectClient: stub,
+		s3Endpoint:     endpoint,
+		S3: S3Config{
+			AccessKeyID:     "minio-access-key",
+			SecretAccessKey: "minio-secret-key",
+			UsePathStyle:    true,
+		},
+	}
+
+	s3Client, err := client.S3Client(t.Context(), "US-TEST-01A")
+	require.NoError(t, err)
+	assert.True(t, s3Client.Options().UsePathStyle)
+
+	credentials, err := s3Client.Options().Credentials.Retrieve(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "minio-access-key", credentials.AccessKeyID)
+	assert.Equal(t, "minio-secret-key", credentials.SecretAccessKey)
+
+	require.NoError(t, putTestBucketTagging(t.Context(), s3Client))
+	assert.Len(t, *accepted, 1)
+	assert.Zero(t, stub.minted.Load(), "static credentials should not mint access keys")
+}
+
+func TestS3Client_SkipCredentialsValidation(t *testing.T) {
+	var listed atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		listed.Add(1)
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	t.Cleanup(server.Close)
+
+	stub := &countingCWObjectClientStub{expiresIn: time.Hour}
+	client := &Client{
+		CWObjectClient: stub,
+		s3Endpoint:     server.URL,
+		Token:          t.Name(),
+		S3:             S3Config{SkipCredentialsValidation: true},
+	}
+
+	_, err := client.S3Client(t.Context(), "US-TEST-01A")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), stub.minted.Load())
+	assert.Zero(t, listed.Load(), "the access key should not be validated")
+}
@@ -0,0 +1,66 @@
+package coreweave
+
+import (
+	"maps"
+	"slices"
+	"strings"
+)
+
+// TagConfig holds the provider-level tag settings, which are applied to every resource that supports tags.
+// A nil *TagConfig is valid and applies no default tags and ignores no tags.
+type TagConfig struct {
+	// DefaultTags are merged into the tags of every resource. Tags set on the resource take precedence.
+	DefaultTags map[string]string
+	// IgnoreKeys and IgnoreKeyPrefixes select tags that are managed outside of Terraform. They are neither
+	// reported in state nor removed from the resource.
+	IgnoreKeys        []string
+	IgnoreKeyPrefixes []string
+}
+
+// Merge returns the default tags overlaid with the resource tags.
+func (c *TagConfig) Merge(resourceTags map[string]string) map[string]string {
+	merged := map[string]string{}
+	if c != nil {
+		maps.Copy(merged, c.DefaultTags)
+	}
+	maps.Copy(merged, resourceTags)
+	return merged
+}
+
+// IsDefault reports whether key is a default tag with the given value.
+func (c *TagConfig) IsDefault(key, value string) bool {
+	if c == nil {
+		return false
+	}
+	defaultValue, ok := c.DefaultTags[key]
+	return ok && defaultValue == value
+}
+
+// HasIgnored reports whether any tags are configured to be ignored.
+func (c *TagConfig) HasIgnored() bool {
+	return c != nil && (len(c.IgnoreKeys) > 0 || len(c.IgnoreKeyPrefixes) > 0)
+}
+
+// IsIgnored reports whether the tag key is configured to be ignored.
+func (c *TagConfig) IsIgnored(key string) bool {
+	if c == nil {
+		return false
+	}
+	if slices.Contains(c.IgnoreKeys, key) {
+		return true
+	}
+	return slices.ContainsFunc(c.IgnoreKeyPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(key, prefix)
+	})
+}
+
+// WithoutIgnored returns a copy of tags without the tags that are configured to be ignored.
+func (c *TagConfig) WithoutIgnored(tags map[string]string) map[string]string {
+	filtered := make(map[string]string, len(tags))
+	for key, value := range tags {
+		if !c.IsIgnored(key) {
+			filtered[key] = value
+		}
+	}
+	return filtered
+}
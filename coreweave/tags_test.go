@@ -0,0 +1,39 @@
+package coreweave
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagConfig(t *testing.T) {
+	config := &TagConfig{
+		DefaultTags:       map[string]string{"team": "ml", "env": "prod"},
+		IgnoreKeys:        []string{"owner"},
+		IgnoreKeyPrefixes: []string{"cw:"},
+	}
+
+	assert.Equal(t, map[string]string{"team": "ml", "env": "dev", "app": "web"}, config.Merge(map[string]string{"env": "dev", "app": "web"}))
+
+	assert.True(t, config.IsDefault("team", "ml"))
+	assert.False(t, config.IsDefault("team", "infra"))
+	assert.False(t, config.IsDefault("app", "web"))
+
+	assert.True(t, config.HasIgnored())
+	assert.True(t, config.IsIgnored("owner"))
+	assert.True(t, config.IsIgnored("cw:billing"))
+	assert.False(t, config.IsIgnored("owners"))
+
+	assert.Equal(t, map[string]string{"team": "ml"}, config.WithoutIgnored(map[string]string{"team": "ml", "owner": "alice", "cw:billing": "123"}))
+}
+
+func TestTagConfig_Nil(t *testing.T) {
+	var config *TagConfig
+
+	assert.Equal(t, map[string]string{"app": "web"}, config.Merge(map[string]string{"app": "web"}))
+	assert.Empty(t, config.Merge(nil))
+	assert.False(t, config.IsDefault("app", "web"))
+	assert.False(t, config.HasIgnored())
+	assert.False(t, config.IsIgnored("app"))
+	assert.Equal(t, map[string]string{"app": "web"}, config.WithoutIgnored(map[string]string{"app": "web"}))
+}
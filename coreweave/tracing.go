@@ -0,0 +1,173 @@
+package coreweave
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"connectrpc.com/connect"
+	"connectrpc.com/otelconnect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName  string = "github.com/coreweave/terraform-provider-coreweave"
+	serviceName string = "terraform-provider-coreweave"
+
+	attrResourceType = attribute.Key("coreweave.resource.type")
+	attrOperation    = attribute.Key("coreweave.operation")
+	attrPending      = attribute.Key("coreweave.wait.pending")
+	attrTarget       = attribute.Key("coreweave.wait.target")
+	attrTimeout      = attribute.Key("coreweave.wait.timeout")
+	attrAttempts     = attribute.Key("coreweave.wait.attempts")
+	attrStatus       = attribute.Key("coreweave.status")
+	attrStatusFrom   = attribute.Key("coreweave.status.from")
+	attrStatusTo     = attribute.Key("coreweave.status.to")
+)
+
+// tracingEnabled is set once SetupTracing has registered an exporter, and gates instrumentation that changes the
+// shape of the HTTP clients. Spans from the global tracer are no-ops until then.
+var tracingEnabled atomic.Bool
+
+// SetupTracing registers a global OTLP trace exporter when one is configured through the standard OTEL_* environment
+// variables, i.e. when OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT is set or OTEL_TRACES_EXPORTER
+// is "otlp". The exporter itself reads the remaining OTEL_EXPORTER_OTLP_* variables, such as headers and TLS settings.
+// The returned function flushes and stops the exporter, and must be called before the provider exits.
+func SetupTracing(ctx context.Context, version string) (func(context.Context) error, error) {
+	noop := func(context.Context) error { return nil }
+
+	if !tracingConfigured() {
+		return noop, nil
+	}
+
+	exporter, err := newTraceExporter(ctx)
+	if err != nil {
+		return noop, err
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithSchemaURL(semconv.SchemaURL),
+		resource.WithAttributes(semconv.ServiceName(serviceName), semconv.ServiceVersion(version)),
+		// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES take precedence over the defaults above
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return noop, fmt.Errorf("failed to build OpenTelemetry resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	tracingEnabled.Store(true)
+
+	return provider.Shutdown, nil
+}
+
+func tracingConfigured() bool {
+	if strings.EqualFold(os.Getenv("OTEL_SDK_DISABLED"), "true") {
+		return false
+	}
+
+	switch strings.ToLower(os.Getenv("OTEL_TRACES_EXPORTER")) {
+	case "otlp":
+		return true
+	case "":
+		return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+	default:
+		// "none", or an exporter we do not support
+		return false
+	}
+}
+
+func newTraceExporter(ctx context.Context) (sdktrace.SpanExporter, error) {
+	protocol := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL")
+	if protocol == "" {
+		protocol = os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL")
+	}
+
+	switch protocol {
+	case "grpc":
+		return otlptracegrpc.New(ctx)
+	case "", "http/protobuf":
+		return otlptracehttp.New(ctx)
+	default:
+		return nil, fmt.Errorf("unsupported OTLP protocol %q, expected one of grpc, http/protobuf", protocol)
+	}
+}
+
+func tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// TracingInterceptor returns a Connect interceptor that creates a client span for every API call.
+// It uses the global tracer provider, so it is a no-op unless SetupTracing has enabled an exporter.
+func TracingInterceptor() (connect.Interceptor, error) {
+	return otelconnect.NewInterceptor(otelconnect.WithoutMetrics())
+}
+
+// traceTransport wraps t so every HTTP request is traced, if tracing is enabled.
+func traceTransport(t http.RoundTripper) http.RoundTripper {
+	if !tracingEnabled.Load() {
+		return t
+	}
+	return otelhttp.NewTransport(t)
+}
+
+// WaitForState waits for conf to reach one of its target states inside a span for the given resource type and
+// operation, recording every status transition as a span event. refresh is used in place of conf.Refresh and is
+// passed the span's context, so the API calls made while polling are traced as children of the wait.
+func WaitForState(ctx context.Context, resourceType, operation string, conf retry.StateChangeConf, refresh func(ctx context.Context) (any, string, error)) (any, error) {
+	ctx, span := tracer().Start(ctx, fmt.Sprintf("wait %s %s", resourceType, operation), trace.WithAttributes(
+		attrResourceType.String(resourceType),
+		attrOperation.String(operation),
+		attrPending.StringSlice(conf.Pending),
+		attrTarget.StringSlice(conf.Target),
+		attrTimeout.String(conf.Timeout.String()),
+	))
+	defer span.End()
+
+	var (
+		attempts int
+		status   string
+	)
+	conf.Refresh = func() (any, string, error) {
+		result, newStatus, err := refresh(ctx)
+		attempts++
+		if newStatus != status {
+			span.AddEvent("status transition", trace.WithAttributes(
+				attrStatusFrom.String(status),
+				attrStatusTo.String(newStatus),
+			))
+			status = newStatus
+		}
+		return result, newStatus, err
+	}
+
+	result, err := conf.WaitForStateContext(ctx)
+
+	span.SetAttributes(attrAttempts.Int(attempts), attrStatus.String(status))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return result, err
+}
@@ -0,0 +1,146 @@
+package coreweave
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// recordSpans installs a global tracer provider that records ended spans for the duration of the test.
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	return recorder
+}
+
+func findSpan(t *testing.T, recorder *tracetest.SpanRecorder, name string) sdktrace.ReadOnlySpan {
+	t.Helper()
+
+	for _, span := range recorder.Ended() {
+		if span.Name() == name {
+			return span
+		}
+	}
+	require.Failf(t, "span not found", "no ended span named %q", name)
+	return nil
+}
+
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func TestWaitForState_Tracing(t *testing.T) {
+	recorder := recordSpans(t)
+
+	statuses := []string{"PENDING", "PENDING", "CREATING", "RUNNING"}
+	var parent trace.SpanContext
+
+	conf := retry.StateChangeConf{
+		Pending:      []string{"PENDING", "CREATING"},
+		Target:       []string{"RUNNING"},
+		Timeout:      time.Minute,
+		PollInterval: time.Millisecond,
+	}
+	result, err := WaitForState(t.Context(), "coreweave_cks_cluster", "create", conf, func(ctx context.Context) (any, string, error) {
+		parent = trace.SpanContextFromContext(ctx)
+		status := statuses[0]
+		statuses = statuses[1:]
+		return status, status, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "RUNNING", result)
+
+	span := findSpan(t, recorder, "wait coreweave_cks_cluster create")
+	assert.Equal(t, span.SpanContext(), parent, "refresh should run in the context of the wait span")
+
+	attrs := spanAttributes(span)
+	assert.Equal(t, "coreweave_cks_cluster", attrs[attrResourceType].AsString())
+	assert.Equal(t, "create", attrs[attrOperation].AsString())
+	assert.Equal(t, []string{"RUNNING"}, attrs[attrTarget].AsStringSlice())
+	assert.Equal(t, int64(4), attrs[attrAttempts].AsInt64())
+	assert.Equal(t, "RUNNING", attrs[attrStatus].AsString())
+
+	var transitions []string
+	for _, event := range span.Events() {
+		for _, kv := range event.Attributes {
+			if kv.Key == attrStatusTo {
+				transitions = append(transitions, kv.Value.AsString())
+			}
+		}
+	}
+	assert.Equal(t, []string{"PENDING", "CREATING", "RUNNING"}, transitions)
+}
+
+func TestPollUntil_Tracing(t *testing.T) {
+	recorder := recordSpans(t)
+	errCheck := errors.New("check failed")
+
+	err := PollUntil("bucket tag propagation", t.Context(), time.Millisecond, time.Minute, func(ctx context.Context) (bool, error) {
+		return false, errCheck
+	})
+	require.ErrorIs(t, err, errCheck)
+
+	span := findSpan(t, recorder, "poll bucket tag propagation")
+	assert.Equal(t, codes.Error, span.Status().Code)
+	assert.Equal(t, int64(1), spanAttributes(span)[attrAttempts].AsInt64())
+}
+
+func TestTracingConfigured(t *testing.T) {
+	tests := map[string]struct {
+		env  map[string]string
+		want bool
+	}{
+		"unset": {
+			want: false,
+		},
+		"endpoint": {
+			env:  map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318"},
+			want: true,
+		},
+		"traces endpoint": {
+			env:  map[string]string{"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT": "http://localhost:4318/v1/traces"},
+			want: true,
+		},
+		"otlp exporter": {
+			env:  map[string]string{"OTEL_TRACES_EXPORTER": "otlp"},
+			want: true,
+		},
+		"exporter none": {
+			env:  map[string]string{"OTEL_TRACES_EXPORTER": "none", "OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318"},
+			want: false,
+		},
+		"sdk disabled": {
+			env:  map[string]string{"OTEL_SDK_DISABLED": "true", "OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318"},
+			want: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, key := range []string{"OTEL_SDK_DISABLED", "OTEL_TRACES_EXPORTER", "OTEL_EXPORTER_OTLP_ENDPOINT", "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"} {
+				t.Setenv(key, tt.env[key])
+			}
+			assert.Equal(t, tt.want, tracingConfigured())
+		})
+	}
+}
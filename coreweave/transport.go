@@ -0,0 +1,153 @@
+package coreweave
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+
+	"connectrpc.com/connect"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// TransportConfig customizes the TLS and proxy settings of the HTTP transports used for the CoreWeave API and S3.
+// The zero value keeps the system trust store and the standard HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+type TransportConfig struct {
+	// CABundleFile is a PEM file of CA certificates to trust in addition to the system trust store.
+	CABundleFile string
+	// ClientCertFile and ClientKeyFile are the PEM certificate and key presented for mutual TLS. Both or neither must be set.
+	ClientCertFile string
+	ClientKeyFile  string
+	// ProxyURL is the proxy used for all requests, in place of HTTP_PROXY and HTTPS_PROXY.
+	ProxyURL string
+	// NoProxy is a comma-separated list of hosts, domains, IP addresses or CIDRs that bypass the proxy, in place of NO_PROXY.
+	NoProxy string
+	// Cassette, if set, records or replays all API and S3 traffic.
+	Cassette *Cassette
+}
+
+// transportSettings holds a loaded TransportConfig, so certificate files are read once and shared by every transport.
+type transportSettings struct {
+	tlsConfig *tls.Config
+	proxy     func(*http.Request) (*url.URL, error)
+	cassette  *Cassette
+}
+
+// load reads the configured certificate files and resolves the proxy settings.
+// It returns nil settings if nothing is configured, leaving the transport defaults in place.
+func (cfg TransportConfig) load() (*transportSettings, error) {
+	settings := &transportSettings{cassette: cfg.Cassette}
+
+	if cfg.CABundleFile != "" || cfg.ClientCertFile != "" || cfg.ClientKeyFile != "" {
+		tlsConfig, err := cfg.loadTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		settings.tlsConfig = tlsConfig
+	}
+
+	if cfg.ProxyURL != "" || cfg.NoProxy != "" {
+		proxy, err := cfg.proxyFunc()
+		if err != nil {
+			return nil, err
+		}
+		settings.proxy = proxy
+	}
+
+	if settings.tlsConfig == nil && settings.proxy == nil && settings.cassette == nil {
+		return nil, nil
+	}
+
+	return settings, nil
+}
+
+func (cfg TransportConfig) loadTLSConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if cfg.CABundleFile != "" {
+		pem, err := os.ReadFile(cfg.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle file: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no PEM certificates found in CA bundle file %q", cfg.CABundleFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if (cfg.ClientCertFile == "") != (cfg.ClientKeyFile == "") {
+		return nil, errors.New("client certificate and client key must be set together")
+	}
+	if cfg.ClientCertFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.ClientCertFile, cfg.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// proxyFunc builds a proxy function from the standard proxy environment variables, overridden by ProxyURL and NoProxy.
+func (cfg TransportConfig) proxyFunc() (func(*http.Request) (*url.URL, error), error) {
+	proxyConfig := httpproxy.FromEnvironment()
+
+	if cfg.ProxyURL != "" {
+		u, err := url.Parse(cfg.ProxyURL)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q", cfg.ProxyURL)
+		}
+		proxyConfig.HTTPProxy = cfg.ProxyURL
+		proxyConfig.HTTPSProxy = cfg.ProxyURL
+	}
+	if cfg.NoProxy != "" {
+		proxyConfig.NoProxy = cfg.NoProxy
+	}
+
+	proxy := proxyConfig.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxy(req.URL)
+	}, nil
+}
+
+// apply configures t with the loaded settings. It is a no-op on nil settings.
+func (s *transportSettings) apply(t *http.Transport) {
+	if s == nil {
+		return
+	}
+	if s.tlsConfig != nil {
+		t.TLSClientConfig = s.tlsConfig.Clone()
+	}
+	if s.proxy != nil {
+		t.Proxy = s.proxy
+	}
+}
+
+// wrap installs the cassette, if any, around the transport of c. c must already be configured, so that the cassette
+// sits outside of its retries.
+func (s *transportSettings) wrap(c *http.Client) {
+	if s == nil || s.cassette == nil {
+		return
+	}
+	c.Transport = s.cassette.Transport(c.Transport)
+}
+
+// interceptors returns interceptors with the cassette's appended, so that it records calls as they are sent.
+func (s *transportSettings) interceptors(interceptors []connect.Interceptor) []connect.Interceptor {
+	if s == nil || s.cassette == nil {
+		return interceptors
+	}
+	return append(slices.Clip(interceptors), s.cassette.Interceptor())
+}
@@ -0,0 +1,126 @@
+package coreweave
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestFile(t *testing.T, name string, contents []byte) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, contents, 0o600))
+	return path
+}
+
+func TestTransportConfig_CABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	caBundle := writeTestFile(t, "ca.pem", pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	noRetry := RetryConfig{MaxAttempts: 0, MinWait: DefaultRetryMinWait, MaxWait: DefaultRetryMaxWait}
+
+	untrusted, err := NewClient(server.URL, server.URL, time.Second, noRetry, TransportConfig{})
+	require.NoError(t, err)
+	_, err = untrusted.s3HttpClient().Get(server.URL) //nolint:noctx
+	require.Error(t, err, "server certificate should not be trusted without the CA bundle")
+
+	trusted, err := NewClient(server.URL, server.URL, time.Second, noRetry, TransportConfig{CABundleFile: caBundle})
+	require.NoError(t, err)
+	resp, err := trusted.s3HttpClient().Get(server.URL) //nolint:noctx
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestTransportConfig_Invalid(t *testing.T) {
+	tests := map[string]struct {
+		config  TransportConfig
+		wantErr string
+	}{
+		"missing CA bundle": {
+			config:  TransportConfig{CABundleFile: filepath.Join(t.TempDir(), "missing.pem")},
+			wantErr: "failed to read CA bundle file",
+		},
+		"CA bundle without certificates": {
+			config:  TransportConfig{CABundleFile: writeTestFile(t, "ca.pem", []byte("not a certificate"))},
+			wantErr: "no PEM certificates found",
+		},
+		"client certificate without key": {
+			config:  TransportConfig{ClientCertFile: writeTestFile(t, "client.pem", []byte("cert"))},
+			wantErr: "must be set together",
+		},
+		"invalid client certificate": {
+			config: TransportConfig{
+				ClientCertFile: writeTestFile(t, "client.pem", []byte("cert")),
+				ClientKeyFile:  writeTestFile(t, "client.key", []byte("key")),
+			},
+			wantErr: "failed to load client certificate",
+		},
+		"invalid proxy URL": {
+			config:  TransportConfig{ProxyURL: "proxy.example.com"},
+			wantErr: "invalid proxy URL",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := tt.config.load()
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestTransportConfig_Proxy(t *testing.T) {
+	settings, err := TransportConfig{
+		ProxyURL: "http://proxy.example.com:3128",
+		NoProxy:  ".internal.example.com,10.0.0.0/8",
+	}.load()
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		url  string
+		want string
+	}{
+		"proxied":            {url: "https://api.coreweave.com/", want: "http://proxy.example.com:3128"},
+		"excluded domain":    {url: "https://api.internal.example.com/", want: ""},
+		"excluded cidr":      {url: "https://10.1.2.3/", want: ""},
+		"proxied over http":  {url: "http://cwobject.com/", want: "http://proxy.example.com:3128"},
+		"proxied other host": {url: "https://example.com/", want: "http://proxy.example.com:3128"},
+	}
+
+	transport := &http.Transport{}
+	settings.apply(transport)
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, tt.url, nil) //nolint:noctx
+			require.NoError(t, err)
+
+			proxy, err := transport.Proxy(req)
+			require.NoError(t, err)
+			if tt.want == "" {
+				assert.Nil(t, proxy)
+			} else {
+				require.NotNil(t, proxy)
+				assert.Equal(t, tt.want, proxy.String())
+			}
+		})
+	}
+}
+
+func TestTransportConfig_Empty(t *testing.T) {
+	settings, err := TransportConfig{}.load()
+	require.NoError(t, err)
+	assert.Nil(t, settings)
+}
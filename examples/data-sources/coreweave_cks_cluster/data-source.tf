@@ -1,3 +1,8 @@
 data "coreweave_cks_cluster" "default" {
   id = "1063bce6-6e5b-4b0a-b73a-7e6106b2a77c"
 }
+
+data "coreweave_cks_cluster" "by_name" {
+  name = "shared"
+  zone = "US-EAST-04A"
+}
@@ -0,0 +1,9 @@
+data "coreweave_cks_clusters" "running" {
+  zone       = "US-EAST-04A"
+  name_regex = "^shared-"
+  status     = "STATUS_RUNNING"
+}
+
+output "cluster_ids" {
+  value = data.coreweave_cks_clusters.running.clusters[*].id
+}
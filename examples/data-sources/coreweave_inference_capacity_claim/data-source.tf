@@ -0,0 +1,7 @@
+data "coreweave_inference_capacity_claim" "reserved" {
+  name = "reserved"
+}
+
+output "allocated_instances" {
+  value = data.coreweave_inference_capacity_claim.reserved.allocated_instances
+}
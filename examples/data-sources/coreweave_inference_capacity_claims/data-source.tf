@@ -0,0 +1,5 @@
+data "coreweave_inference_capacity_claims" "all" {}
+
+output "pending_instances" {
+  value = { for claim in data.coreweave_inference_capacity_claims.all.capacity_claims : claim.name => claim.pending_instances }
+}
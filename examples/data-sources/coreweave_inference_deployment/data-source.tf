@@ -0,0 +1,7 @@
+data "coreweave_inference_deployment" "llama" {
+  name = "llama"
+}
+
+output "deployment_status" {
+  value = data.coreweave_inference_deployment.llama.status
+}
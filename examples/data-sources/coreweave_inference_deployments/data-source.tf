@@ -0,0 +1,12 @@
+data "coreweave_inference_gateway" "public" {
+  name = "public"
+}
+
+data "coreweave_inference_deployments" "public" {
+  gateway_id = data.coreweave_inference_gateway.public.id
+  status     = "STATUS_READY"
+}
+
+output "deployment_names" {
+  value = data.coreweave_inference_deployments.public.deployments[*].name
+}
@@ -0,0 +1,7 @@
+data "coreweave_inference_gateway" "public" {
+  name = "public"
+}
+
+output "gateway_endpoints" {
+  value = data.coreweave_inference_gateway.public.endpoints
+}
@@ -0,0 +1,8 @@
+data "coreweave_inference_gateways" "ready" {
+  name_regex = "^prod-"
+  status     = "STATUS_READY"
+}
+
+output "gateway_ids" {
+  value = data.coreweave_inference_gateways.ready.gateways[*].id
+}
@@ -0,0 +1,8 @@
+data "coreweave_networking_vpcs" "shared" {
+  zone       = "US-EAST-04A"
+  name_regex = "^shared-"
+}
+
+output "vpc_ids" {
+  value = { for vpc in data.coreweave_networking_vpcs.shared.vpcs : vpc.name => vpc.id }
+}
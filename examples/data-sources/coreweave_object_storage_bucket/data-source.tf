@@ -0,0 +1,7 @@
+data "coreweave_object_storage_bucket" "models" {
+  name = "my-model-weights"
+}
+
+output "models_endpoint" {
+  value = data.coreweave_object_storage_bucket.models.virtual_host_endpoint
+}
@@ -0,0 +1,8 @@
+data "coreweave_object_storage_buckets" "training" {
+  zone       = "US-EAST-04A"
+  name_regex = "^training-"
+}
+
+output "versioned_buckets" {
+  value = [for bucket in data.coreweave_object_storage_buckets.training.buckets : bucket.name if bucket.versioning_status == "Enabled"]
+}
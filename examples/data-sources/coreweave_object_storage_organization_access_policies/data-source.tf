@@ -0,0 +1,15 @@
+data "coreweave_object_storage_organization_access_policies" "public" {
+  principal = "*"
+}
+
+check "no_public_full_access" {
+  assert {
+    condition = alltrue([
+      for policy in data.coreweave_object_storage_organization_access_policies.public.policies : alltrue([
+        for statement in policy.statements :
+        !(statement.effect == "Allow" && contains(statement.actions, "s3:*") && contains(statement.principals, "*"))
+      ])
+    ])
+    error_message = "An organization access policy grants s3:* to every principal."
+  }
+}
@@ -0,0 +1,28 @@
+# Obtain short-lived credentials from a credential plugin
+ephemeral "coreweave_cks_kubeconfig" "default" {
+  cluster_id = coreweave_cks_cluster.default.id
+
+  exec = {
+    command = "my-credential-helper"
+    args    = ["get-token", "--cluster", coreweave_cks_cluster.default.name]
+  }
+}
+
+# Alternatively, authenticate with the provider's API token. This is a long-lived token with the full scope of the
+# provider's credentials, not one limited to the cluster.
+ephemeral "coreweave_cks_kubeconfig" "api_token" {
+  cluster_id    = coreweave_cks_cluster.default.id
+  use_api_token = true
+}
+
+provider "kubernetes" {
+  host  = ephemeral.coreweave_cks_kubeconfig.api_token.server
+  token = ephemeral.coreweave_cks_kubeconfig.api_token.token
+}
+
+provider "helm" {
+  kubernetes = {
+    host  = ephemeral.coreweave_cks_kubeconfig.api_token.server
+    token = ephemeral.coreweave_cks_kubeconfig.api_token.token
+  }
+}
@@ -0,0 +1,17 @@
+ephemeral "coreweave_object_storage_access_key" "default" {
+  duration = "1h"
+}
+
+provider "aws" {
+  access_key = ephemeral.coreweave_object_storage_access_key.default.access_key_id
+  secret_key = ephemeral.coreweave_object_storage_access_key.default.secret_access_key
+  region     = "US-EAST-04A"
+
+  endpoints {
+    s3 = ephemeral.coreweave_object_storage_access_key.default.endpoint
+  }
+
+  skip_credentials_validation = true
+  skip_region_validation      = true
+  skip_requesting_account_id  = true
+}
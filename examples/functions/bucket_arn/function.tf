@@ -0,0 +1,15 @@
+data "coreweave_object_storage_bucket_policy_document" "read_only" {
+  version = "2012-10-17"
+  statement {
+    sid    = "read-only"
+    effect = "Allow"
+    action = ["s3:GetObject", "s3:ListBucket"]
+    principal = {
+      "CW" : ["*"]
+    }
+    resource = [
+      provider::coreweave::bucket_arn(coreweave_object_storage_bucket.default.name),
+      provider::coreweave::object_arn(coreweave_object_storage_bucket.default.name, "*"),
+    ]
+  }
+}
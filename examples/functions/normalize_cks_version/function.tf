@@ -0,0 +1,9 @@
+variable "kubernetes_version" {
+  type    = string
+  default = "1.35.2"
+}
+
+output "cks_version" {
+  # v1.35
+  value = provider::coreweave::normalize_cks_version(var.kubernetes_version)
+}
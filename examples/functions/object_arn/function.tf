@@ -0,0 +1,4 @@
+output "models_arn" {
+  # arn:aws:s3:::my-bucket/models/*
+  value = provider::coreweave::object_arn("my-bucket", "models/*")
+}
@@ -0,0 +1,8 @@
+locals {
+  # { bucket = "my-bucket", key = "models/*" }
+  models = provider::coreweave::parse_arn("arn:aws:s3:::my-bucket/models/*")
+}
+
+output "models_bucket" {
+  value = local.models.bucket
+}
@@ -0,0 +1,16 @@
+variable "model_uri" {
+  type    = string
+  default = "s3://my-models/llama-3.1-8b-instruct"
+}
+
+locals {
+  model = provider::coreweave::parse_s3_uri(var.model_uri)
+}
+
+output "model" {
+  # { bucket = "my-models", path = "llama-3.1-8b-instruct" }
+  value = {
+    bucket = local.model.bucket
+    path   = local.model.path
+  }
+}
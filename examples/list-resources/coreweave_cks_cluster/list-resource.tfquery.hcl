@@ -0,0 +1,8 @@
+list "coreweave_cks_cluster" "production" {
+  provider = coreweave
+
+  config {
+    zone       = "US-EAST-04A"
+    name_regex = "^prod-"
+  }
+}
@@ -0,0 +1,7 @@
+list "coreweave_inference_capacity_claim" "reserved" {
+  provider = coreweave
+
+  config {
+    name_regex = "^reserved-"
+  }
+}
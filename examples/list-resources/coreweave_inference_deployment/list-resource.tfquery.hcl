@@ -0,0 +1,7 @@
+list "coreweave_inference_deployment" "public" {
+  provider = coreweave
+
+  config {
+    gateway_id = "{{gateway-id}}"
+  }
+}
@@ -0,0 +1,3 @@
+list "coreweave_inference_gateway" "all" {
+  provider = coreweave
+}
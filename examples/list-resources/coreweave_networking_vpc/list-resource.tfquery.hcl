@@ -0,0 +1,7 @@
+list "coreweave_networking_vpc" "us_east" {
+  provider = coreweave
+
+  config {
+    zone = "US-EAST-04A"
+  }
+}
@@ -0,0 +1,9 @@
+# the zone and tags of each bucket are read from S3 when the resource is included
+list "coreweave_object_storage_bucket" "models" {
+  provider         = coreweave
+  include_resource = true
+
+  config {
+    name_regex = "^models-"
+  }
+}
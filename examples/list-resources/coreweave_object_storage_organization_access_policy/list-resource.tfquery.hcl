@@ -0,0 +1,4 @@
+list "coreweave_object_storage_organization_access_policy" "all" {
+  provider         = coreweave
+  include_resource = true
+}
@@ -0,0 +1,6 @@
+import {
+  to = coreweave_cks_cluster.default
+  identity = {
+    id = "{{id}}"
+  }
+}
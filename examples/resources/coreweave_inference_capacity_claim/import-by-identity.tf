@@ -0,0 +1,6 @@
+import {
+  to = coreweave_inference_capacity_claim.example
+  identity = {
+    id = "{{capacity-claim-id}}"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = coreweave_inference_deployment.example
+  identity = {
+    id = "{{deployment-id}}"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = coreweave_inference_gateway.example
+  identity = {
+    id = "{{gateway-id}}"
+  }
+}
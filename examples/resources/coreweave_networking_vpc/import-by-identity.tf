@@ -0,0 +1,6 @@
+import {
+  to = coreweave_networking_vpc.default
+  identity = {
+    id = "{{id}}"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = coreweave_object_storage_bucket.default
+  identity = {
+    name = "{{name}}"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = coreweave_object_storage_organization_access_policy.default
+  identity = {
+    name = "{{name}}"
+  }
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	CoreweaveProfileEnvVar    string = "COREWEAVE_PROFILE"
+	CoreweaveConfigFileEnvVar string = "COREWEAVE_CONFIG_FILE"
+	DefaultProfileName        string = "default"
+)
+
+// ProfileConfig holds the provider settings that can be read from a profile in the shared config file.
+type ProfileConfig struct {
+	Token       string `yaml:"token"`
+	Endpoint    string `yaml:"endpoint"`
+	S3Endpoint  string `yaml:"s3_endpoint"`
+	HTTPTimeout string `yaml:"http_timeout"`
+
+	CABundleFile   string `yaml:"ca_bundle_file"`
+	ClientCertFile string `yaml:"client_cert_file"`
+	ClientKeyFile  string `yaml:"client_key_file"`
+	ProxyURL       string `yaml:"proxy_url"`
+	NoProxy        string `yaml:"no_proxy"`
+}
+
+// set assigns a single INI key to the matching field, returning false if the key is not recognized.
+func (p *ProfileConfig) set(key, value string) bool {
+	switch key {
+	case "token":
+		p.Token = value
+	case "endpoint":
+		p.Endpoint = value
+	case "s3_endpoint":
+		p.S3Endpoint = value
+	case "http_timeout":
+		p.HTTPTimeout = value
+	case "ca_bundle_file":
+		p.CABundleFile = value
+	case "client_cert_file":
+		p.ClientCertFile = value
+	case "client_key_file":
+		p.ClientKeyFile = value
+	case "proxy_url":
+		p.ProxyURL = value
+	case "no_proxy":
+		p.NoProxy = value
+	default:
+		return false
+	}
+	return true
+}
+
+// DefaultConfigFilePath returns the location of the shared config file, ~/.coreweave/config.
+func DefaultConfigFilePath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".coreweave", "config"), nil
+}
+
+// LoadProfile reads the named profile from the shared config file at path.
+// If required is false, a missing file or profile is not an error and a nil profile is returned;
+// this allows the implicit "default" profile to be absent.
+func LoadProfile(path, name string, required bool) (*ProfileConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) && !required {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read config file %q: %w", path, err)
+	}
+
+	profiles, err := parseConfigFile(path, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file %q: %w", path, err)
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		if !required {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("profile %q not found in config file %q", name, path)
+	}
+
+	if profile.HTTPTimeout != "" {
+		if _, err := parseDuration(profile.HTTPTimeout); err != nil {
+			return nil, fmt.Errorf("profile %q in config file %q has an invalid http_timeout: %w", name, path, err)
+		}
+	}
+
+	return &profile, nil
+}
+
+// parseConfigFile parses the shared config file as YAML or INI. Files ending in .yaml or .yml are always YAML and
+// files ending in .ini are always INI; otherwise the file is treated as INI if its first meaningful line is a section header.
+func parseConfigFile(path string, data []byte) (map[string]ProfileConfig, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return parseYAMLConfig(data)
+	case ".ini":
+		return parseINIConfig(data)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			return parseINIConfig(data)
+		}
+		break
+	}
+
+	return parseYAMLConfig(data)
+}
+
+// parseYAMLConfig parses a YAML config file, where each top-level key is a profile name:
+//
+//	default:
+//	  token: CW-SECRET-...
+//	staging:
+//	  token: CW-SECRET-...
+//	  endpoint: https://api.staging.example.com/
+func parseYAMLConfig(data []byte) (map[string]ProfileConfig, error) {
+	profiles := map[string]ProfileConfig{}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&profiles); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// parseINIConfig parses an INI config file, where each section is a profile name. As with the AWS shared config file,
+// sections may also be written as [profile <name>]:
+//
+//	[default]
+//	token = CW-SECRET-...
+//
+//	[profile staging]
+//	token = CW-SECRET-...
+//	endpoint = https://api.staging.example.com/
+func parseINIConfig(data []byte) (map[string]ProfileConfig, error) {
+	profiles := map[string]ProfileConfig{}
+
+	var current string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("line %d: unterminated section header %q", lineNum, line)
+			}
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if rest, ok := strings.CutPrefix(name, "profile "); ok {
+				name = strings.TrimSpace(rest)
+			}
+			if name == "" {
+				return nil, fmt.Errorf("line %d: empty section name", lineNum)
+			}
+			current = name
+			if _, ok := profiles[current]; !ok {
+				profiles[current] = ProfileConfig{}
+			}
+			continue
+		}
+
+		if current == "" {
+			return nil, fmt.Errorf("line %d: key outside of a profile section", lineNum)
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected 'key = value', got %q", lineNum, line)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.Trim(strings.TrimSpace(value), `"'`)
+
+		profile := profiles[current]
+		if !profile.set(key, value) {
+			return nil, fmt.Errorf("line %d: unknown key %q in profile %q", lineNum, key, current)
+		}
+		profiles[current] = profile
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
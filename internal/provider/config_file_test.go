@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testINIConfig = `# shared CoreWeave config
+[default]
+token = CW-SECRET-default
+
+[profile staging]
+token = "CW-SECRET-staging"
+endpoint = https://api.staging.example.com/
+s3_endpoint = https://s3.staging.example.com
+http_timeout = 30s
+proxy_url = http://proxy.example.com:3128
+`
+
+const testYAMLConfig = `# shared CoreWeave config
+default:
+  token: CW-SECRET-default
+staging:
+  token: CW-SECRET-staging
+  endpoint: https://api.staging.example.com/
+  s3_endpoint: https://s3.staging.example.com
+  http_timeout: 30s
+  proxy_url: http://proxy.example.com:3128
+`
+
+func writeConfigFile(t *testing.T, name, contents string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+	return path
+}
+
+func TestLoadProfile(t *testing.T) {
+	staging := &ProfileConfig{
+		Token:       "CW-SECRET-staging",
+		Endpoint:    "https://api.staging.example.com/",
+		S3Endpoint:  "https://s3.staging.example.com",
+		HTTPTimeout: "30s",
+		ProxyURL:    "http://proxy.example.com:3128",
+	}
+
+	tests := map[string]struct {
+		fileName string
+		contents string
+	}{
+		"ini":                 {fileName: "config", contents: testINIConfig},
+		"ini with extension":  {fileName: "config.ini", contents: testINIConfig},
+		"yaml":                {fileName: "config", contents: testYAMLConfig},
+		"yaml with extension": {fileName: "config.yaml", contents: testYAMLConfig},
+		"yml with extension":  {fileName: "config.yml", contents: testYAMLConfig},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := writeConfigFile(t, tt.fileName, tt.contents)
+
+			profile, err := LoadProfile(path, "staging", true)
+			require.NoError(t, err)
+			assert.Equal(t, staging, profile)
+
+			profile, err = LoadProfile(path, DefaultProfileName, false)
+			require.NoError(t, err)
+			assert.Equal(t, &ProfileConfig{Token: "CW-SECRET-default"}, profile)
+		})
+	}
+}
+
+func TestLoadProfile_Missing(t *testing.T) {
+	path := writeConfigFile(t, "config", testINIConfig)
+	missingPath := filepath.Join(t.TempDir(), "config")
+
+	profile, err := LoadProfile(missingPath, DefaultProfileName, false)
+	require.NoError(t, err)
+	assert.Nil(t, profile)
+
+	profile, err = LoadProfile(path, "production", false)
+	require.NoError(t, err)
+	assert.Nil(t, profile)
+
+	_, err = LoadProfile(missingPath, "staging", true)
+	assert.ErrorContains(t, err, "failed to read config file")
+
+	_, err = LoadProfile(path, "production", true)
+	assert.ErrorContains(t, err, `profile "production" not found`)
+}
+
+func TestLoadProfile_Invalid(t *testing.T) {
+	tests := map[string]struct {
+		fileName string
+		contents string
+		wantErr  string
+	}{
+		"ini unknown key": {
+			contents: "[default]\ntokn = CW-SECRET-default\n",
+			wantErr:  `unknown key "tokn"`,
+		},
+		"ini key outside section": {
+			fileName: "config.ini",
+			contents: "token = CW-SECRET-default\n[default]\n",
+			wantErr:  "key outside of a profile section",
+		},
+		"ini missing separator": {
+			contents: "[default]\ntoken\n",
+			wantErr:  "expected 'key = value'",
+		},
+		"yaml unknown key": {
+			contents: "default:\n  tokn: CW-SECRET-default\n",
+			wantErr:  "field tokn not found",
+		},
+		"invalid http_timeout": {
+			contents: "[default]\nhttp_timeout = soon\n",
+			wantErr:  "invalid http_timeout",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fileName := tt.fileName
+			if fileName == "" {
+				fileName = "config"
+			}
+			path := writeConfigFile(t, fileName, tt.contents)
+
+			_, err := LoadProfile(path, DefaultProfileName, false)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"time"
 
 	"connectrpc.com/connect"
@@ -13,8 +14,12 @@ import (
 	"github.com/coreweave/terraform-provider-coreweave/coreweave/inference"
 	"github.com/coreweave/terraform-provider-coreweave/coreweave/networking"
 	objectstorage "github.com/coreweave/terraform-provider-coreweave/coreweave/object_storage"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -26,13 +31,33 @@ import (
 )
 
 const (
-	CoreweaveApiTokenEnvVar     string        = "COREWEAVE_API_TOKEN"    //nolint:gosec,staticcheck
-	CoreweaveApiEndpointEnvVar  string        = "COREWEAVE_API_ENDPOINT" //nolint:gosec,staticcheck
-	CoreWeaveS3EndpointEnvVar   string        = "COREWEAVE_S3_ENDPOINT"
-	CoreweaveHTTPTimeoutEnvVar  string        = "COREWEAVE_HTTP_TIMEOUT"
-	CoreweaveApiEndpointDefault string        = "https://api.coreweave.com/" //nolint:staticcheck
-	CoreWeaveS3EndpointDefault  string        = "https://cwobject.com"
-	DefaultHTTPTimeout          time.Duration = 10 * time.Second
+	CoreweaveApiTokenEnvVar         string        = "COREWEAVE_API_TOKEN"    //nolint:gosec,staticcheck
+	CoreweaveApiEndpointEnvVar      string        = "COREWEAVE_API_ENDPOINT" //nolint:gosec,staticcheck
+	CoreWeaveS3EndpointEnvVar       string        = "COREWEAVE_S3_ENDPOINT"
+	CoreweaveHTTPTimeoutEnvVar      string        = "COREWEAVE_HTTP_TIMEOUT"
+	CoreweaveRetryMaxAttemptsEnvVar string        = "COREWEAVE_RETRY_MAX_ATTEMPTS"
+	CoreweaveRetryMinWaitEnvVar     string        = "COREWEAVE_RETRY_MIN_WAIT"
+	CoreweaveRetryMaxWaitEnvVar     string        = "COREWEAVE_RETRY_MAX_WAIT"
+	CoreweaveCABundleFileEnvVar     string        = "COREWEAVE_CA_BUNDLE_FILE"
+	CoreweaveClientCertFileEnvVar   string        = "COREWEAVE_CLIENT_CERT_FILE"
+	CoreweaveClientKeyFileEnvVar    string        = "COREWEAVE_CLIENT_KEY_FILE"
+	CoreweaveProxyURLEnvVar         string        = "COREWEAVE_PROXY_URL"
+	CoreweaveNoProxyEnvVar          string        = "COREWEAVE_NO_PROXY"
+	CoreweaveApiEndpointDefault     string        = "https://api.coreweave.com/" //nolint:staticcheck
+	CoreWeaveS3EndpointDefault      string        = "https://cwobject.com"
+	DefaultHTTPTimeout              time.Duration = 10 * time.Second
+)
+
+const (
+	CoreweaveS3AccessKeyIDEnvVar               string = "COREWEAVE_S3_ACCESS_KEY_ID"
+	CoreweaveS3SecretAccessKeyEnvVar           string = "COREWEAVE_S3_SECRET_ACCESS_KEY" //nolint:gosec
+	CoreweaveS3UsePathStyleEnvVar              string = "COREWEAVE_S3_USE_PATH_STYLE"
+	CoreweaveS3SkipCredentialsValidationEnvVar string = "COREWEAVE_S3_SKIP_CREDENTIALS_VALIDATION"
+)
+
+const (
+	CoreweaveRecordEnvVar string = "COREWEAVE_RECORD"
+	CoreweaveReplayEnvVar string = "COREWEAVE_REPLAY"
 )
 
 // TestProtoV6ProviderFactories are used to instantiate a provider during
@@ -51,8 +76,10 @@ var TestProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer,
 
 // Ensure CoreweaveProvider satisfies various provider interfaces.
 var (
-	_ provider.Provider              = &CoreweaveProvider{}
-	_ provider.ProviderWithFunctions = &CoreweaveProvider{}
+	_ provider.Provider                       = &CoreweaveProvider{}
+	_ provider.ProviderWithFunctions          = &CoreweaveProvider{}
+	_ provider.ProviderWithEphemeralResources = &CoreweaveProvider{}
+	_ provider.ProviderWithListResources      = &CoreweaveProvider{}
 )
 
 // CoreweaveProvider defines the provider implementation.
@@ -65,10 +92,44 @@ type CoreweaveProvider struct {
 
 // CoreweaveProviderModel describes the provider data model.
 type CoreweaveProviderModel struct {
-	Endpoint    types.String `tfsdk:"endpoint"`
-	S3Endpoint  types.String `tfsdk:"s3_endpoint"`
-	Token       types.String `tfsdk:"token"`
-	HTTPTimeout types.String `tfsdk:"http_timeout"`
+	Endpoint    types.String      `tfsdk:"endpoint"`
+	S3Endpoint  types.String      `tfsdk:"s3_endpoint"`
+	Token       types.String      `tfsdk:"token"`
+	HTTPTimeout types.String      `tfsdk:"http_timeout"`
+	Profile     types.String      `tfsdk:"profile"`
+	ConfigFile  types.String      `tfsdk:"config_file"`
+	Retry       *RetryModel       `tfsdk:"retry"`
+	DefaultTags *DefaultTagsModel `tfsdk:"default_tags"`
+	IgnoreTags  *IgnoreTagsModel  `tfsdk:"ignore_tags"`
+
+	CABundleFile   types.String `tfsdk:"ca_bundle_file"`
+	ClientCertFile types.String `tfsdk:"client_cert_file"`
+	ClientKeyFile  types.String `tfsdk:"client_key_file"`
+	ProxyURL       types.String `tfsdk:"proxy_url"`
+	NoProxy        types.String `tfsdk:"no_proxy"`
+
+	S3AccessKeyID               types.String `tfsdk:"s3_access_key_id"`
+	S3SecretAccessKey           types.String `tfsdk:"s3_secret_access_key"`
+	S3UsePathStyle              types.Bool   `tfsdk:"s3_use_path_style"`
+	S3SkipCredentialsValidation types.Bool   `tfsdk:"s3_skip_credentials_validation"`
+}
+
+// RetryModel describes the provider retry block.
+type RetryModel struct {
+	MaxAttempts types.Int64  `tfsdk:"max_attempts"`
+	MinWait     types.String `tfsdk:"min_wait"`
+	MaxWait     types.String `tfsdk:"max_wait"`
+}
+
+// DefaultTagsModel describes the provider default_tags block.
+type DefaultTagsModel struct {
+	Tags types.Map `tfsdk:"tags"`
+}
+
+// IgnoreTagsModel describes the provider ignore_tags block.
+type IgnoreTagsModel struct {
+	Keys        types.Set `tfsdk:"keys"`
+	KeyPrefixes types.Set `tfsdk:"key_prefixes"`
 }
 
 func (p *CoreweaveProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -105,6 +166,107 @@ func (p *CoreweaveProvider) Schema(ctx context.Context, req provider.SchemaReque
 					durationValidator{},
 				},
 			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Name of the profile to read from the shared config file. This can also be set via the %s environment variable, which takes precedence. Defaults to `%s`; the `%s` profile is optional, but any other selected profile must exist.", CoreweaveProfileEnvVar, DefaultProfileName, DefaultProfileName),
+				Optional:            true,
+			},
+			"config_file": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Path to the shared config file, in INI or YAML format. This can also be set via the %s environment variable, which takes precedence. Defaults to `~/.coreweave/config`", CoreweaveConfigFileEnvVar),
+				Optional:            true,
+			},
+			"ca_bundle_file": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Path to a PEM file of CA certificates to trust, in addition to the system trust store, for CoreWeave API and Object Storage (S3) requests. Use this when traffic passes through a TLS-intercepting proxy. This can also be set via the %s environment variable, which takes precedence.", CoreweaveCABundleFileEnvVar),
+				Optional:            true,
+			},
+			"client_cert_file": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Path to a PEM client certificate presented for mutual TLS on CoreWeave API and Object Storage (S3) requests. Must be set together with `client_key_file`. This can also be set via the %s environment variable, which takes precedence.", CoreweaveClientCertFileEnvVar),
+				Optional:            true,
+			},
+			"client_key_file": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Path to the PEM private key for `client_cert_file`. This can also be set via the %s environment variable, which takes precedence.", CoreweaveClientKeyFileEnvVar),
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("URL of the HTTP proxy to use for CoreWeave API and Object Storage (S3) requests, e.g. `http://proxy.example.com:3128`. This can also be set via the %s environment variable, which takes precedence. If unset, the standard `HTTPS_PROXY` and `HTTP_PROXY` environment variables are used.", CoreweaveProxyURLEnvVar),
+				Optional:            true,
+				Validators: []validator.String{
+					uriValidator{},
+				},
+			},
+			"no_proxy": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Comma-separated list of hosts, domains, IP addresses or CIDR ranges that bypass the proxy, e.g. `.internal.example.com,10.0.0.0/8`. This can also be set via the %s environment variable, which takes precedence. If unset, the standard `NO_PROXY` environment variable is used.", CoreweaveNoProxyEnvVar),
+				Optional:            true,
+			},
+			"s3_access_key_id": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Static access key ID for the S3 API, used instead of access keys minted with the API token. Use this with `s3_endpoint` to run the Object Storage resources against MinIO or another S3-compatible server. Must be set together with `s3_secret_access_key`. This can also be set via the %s environment variable, which takes precedence.", CoreweaveS3AccessKeyIDEnvVar),
+				Optional:            true,
+			},
+			"s3_secret_access_key": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Static secret access key for `s3_access_key_id`. This can also be set via the %s environment variable, which takes precedence.", CoreweaveS3SecretAccessKeyEnvVar),
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"s3_use_path_style": schema.BoolAttribute{
+				MarkdownDescription: fmt.Sprintf("Address buckets as a path of `s3_endpoint`, e.g. `http://localhost:9000/my-bucket`, rather than as a subdomain of it. Most local S3-compatible servers require this. This can also be set via the %s environment variable, which takes precedence. Defaults to `false`", CoreweaveS3UsePathStyleEnvVar),
+				Optional:            true,
+			},
+			"s3_skip_credentials_validation": schema.BoolAttribute{
+				MarkdownDescription: fmt.Sprintf("Skip waiting for new S3 access keys to be accepted by a `ListBuckets` call before they are used. This can also be set via the %s environment variable, which takes precedence. Defaults to `false`", CoreweaveS3SkipCredentialsValidationEnvVar),
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"default_tags": schema.SingleNestedBlock{
+				MarkdownDescription: "Tags applied to every resource that supports tags, currently `coreweave_object_storage_bucket`. Tags set on a resource take precedence over default tags with the same key.",
+				Attributes: map[string]schema.Attribute{
+					"tags": schema.MapAttribute{
+						MarkdownDescription: "Map of tags to apply to every resource.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+			"ignore_tags": schema.SingleNestedBlock{
+				MarkdownDescription: "Tags that are managed outside of Terraform, e.g. by other systems. Matching tags are neither reported in state nor removed from resources, so they do not cause diffs. They should not also be set in `tags` or `default_tags`.",
+				Attributes: map[string]schema.Attribute{
+					"keys": schema.SetAttribute{
+						MarkdownDescription: "Tag keys to ignore.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+					"key_prefixes": schema.SetAttribute{
+						MarkdownDescription: "Tag key prefixes to ignore.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+			"retry": schema.SingleNestedBlock{
+				MarkdownDescription: "Retry and back-off settings shared by the CoreWeave API and Object Storage (S3) HTTP clients. Requests that fail with a transport error, a `429`, or a retryable `5xx` response are retried with jittered exponential back-off. API calls that create, update or delete resources are only retried when they did not reach the API, i.e. when the connection is refused or the call is rejected with a `429`.",
+				Attributes: map[string]schema.Attribute{
+					"max_attempts": schema.Int64Attribute{
+						MarkdownDescription: fmt.Sprintf("Maximum number of retries after the initial request. Set to `0` to disable retries. This can also be set via the %s environment variable, which takes precedence. Defaults to `%d`", CoreweaveRetryMaxAttemptsEnvVar, coreweave.DefaultRetryMaxAttempts),
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"min_wait": schema.StringAttribute{
+						MarkdownDescription: fmt.Sprintf("Minimum duration to wait between retries. This can also be set via the %s environment variable, which takes precedence. Defaults to `%s`", CoreweaveRetryMinWaitEnvVar, coreweave.DefaultRetryMinWait),
+						Optional:            true,
+						Validators: []validator.String{
+							durationValidator{},
+						},
+					},
+					"max_wait": schema.StringAttribute{
+						MarkdownDescription: fmt.Sprintf("Maximum duration to wait between retries. Must not be less than `min_wait`. This can also be set via the %s environment variable, which takes precedence. Defaults to `%s`", CoreweaveRetryMaxWaitEnvVar, coreweave.DefaultRetryMaxWait),
+						Optional:            true,
+						Validators: []validator.String{
+							durationValidator{},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -125,6 +287,8 @@ func (p *CoreweaveProvider) Configure(ctx context.Context, req provider.Configur
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+	resp.ListResourceData = client
 }
 
 func parseDuration(raw string) (*time.Duration, error) {
@@ -141,9 +305,9 @@ func parseDuration(raw string) (*time.Duration, error) {
 	return &parsed, nil
 }
 
-// Builds a CW client using the provided model, including any defaults or environment variables.
+// Builds a CW client using the provided model, including any defaults, environment variables or shared config file profile.
 // Returns an error if the token is not provided.
-// Variable precedence: 1) env, 2) config, 3) default/error.
+// Variable precedence: 1) env, 2) config, 3) shared config file profile, 4) default/error.
 //
 //nolint:staticcheck
 func BuildClient(ctx context.Context, model CoreweaveProviderModel, tfVersion, providerVersion string) (*coreweave.Client, error) {
@@ -153,6 +317,25 @@ func BuildClient(ctx context.Context, model CoreweaveProviderModel, tfVersion, p
 	httpTimeout := model.HTTPTimeout.ValueString()
 	timeout := DefaultHTTPTimeout
 
+	profile, err := loadProfileConfig(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil {
+		if endpoint == "" {
+			endpoint = profile.Endpoint
+		}
+		if s3Endpoint == "" {
+			s3Endpoint = profile.S3Endpoint
+		}
+		if token == "" {
+			token = profile.Token
+		}
+		if httpTimeout == "" {
+			httpTimeout = profile.HTTPTimeout
+		}
+	}
+
 	// An error should not be able to happen in this case, as we specify a validator on the StringAttribute on the provider schema
 	// but for posterity we check for the error anyway
 	if userSpecified, err := parseDuration(httpTimeout); err == nil {
@@ -177,9 +360,29 @@ func BuildClient(ctx context.Context, model CoreweaveProviderModel, tfVersion, p
 		}
 	}
 
-	if token == "" {
+	s3, err := buildS3Config(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+
+	// static S3 credentials are enough for the Object Storage resources that only use the S3 API
+	if token == "" && !s3.HasStaticCredentials() {
 		return nil, errors.New("token is required for coreweave client instantiation")
 	}
+
+	retry, err := buildRetryConfig(ctx, model.Retry)
+	if err != nil {
+		return nil, err
+	}
+	transport := buildTransportConfig(model, profile)
+	transport.Cassette, err = openCassette()
+	if err != nil {
+		return nil, err
+	}
+	tags, err := buildTagConfig(ctx, model)
+	if err != nil {
+		return nil, err
+	}
 	if endpoint == "" {
 		endpoint = CoreweaveApiEndpointDefault
 	}
@@ -188,6 +391,7 @@ func BuildClient(ctx context.Context, model CoreweaveProviderModel, tfVersion, p
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("using http client timeout: %v", timeout))
+	tflog.Debug(ctx, fmt.Sprintf("using http client retry settings: max_attempts=%d min_wait=%v max_wait=%v", retry.MaxAttempts, retry.MinWait, retry.MaxWait))
 
 	headerInterceptor := connect.UnaryInterceptorFunc(
 		func(next connect.UnaryFunc) connect.UnaryFunc {
@@ -199,7 +403,211 @@ func BuildClient(ctx context.Context, model CoreweaveProviderModel, tfVersion, p
 		},
 	)
 
-	return coreweave.NewClient(endpoint, s3Endpoint, timeout, headerInterceptor, coreweave.TFLogInterceptor()), nil
+	tracingInterceptor, err := coreweave.TracingInterceptor()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tracing interceptor: %w", err)
+	}
+
+	client, err := coreweave.NewClient(endpoint, s3Endpoint, timeout, retry, transport, tracingInterceptor, headerInterceptor, coreweave.TFLogInterceptor())
+	if err != nil {
+		return nil, err
+	}
+	client.Tags = tags
+	client.Token = token
+	client.S3 = s3
+
+	return client, nil
+}
+
+// buildTagConfig reads the default_tags and ignore_tags blocks.
+func buildTagConfig(ctx context.Context, model CoreweaveProviderModel) (*coreweave.TagConfig, error) {
+	tags := &coreweave.TagConfig{}
+
+	var diags diag.Diagnostics
+	if model.DefaultTags != nil {
+		diags.Append(model.DefaultTags.Tags.ElementsAs(ctx, &tags.DefaultTags, false)...)
+	}
+	if model.IgnoreTags != nil {
+		diags.Append(model.IgnoreTags.Keys.ElementsAs(ctx, &tags.IgnoreKeys, false)...)
+		diags.Append(model.IgnoreTags.KeyPrefixes.ElementsAs(ctx, &tags.IgnoreKeyPrefixes, false)...)
+	}
+	if diags.HasError() {
+		return nil, fmt.Errorf("invalid tag configuration: %s", diags.Errors()[0].Detail())
+	}
+
+	return tags, nil
+}
+
+// buildS3Config resolves the S3 credentials and addressing settings.
+// Variable precedence: 1) env, 2) config, 3) default.
+func buildS3Config(ctx context.Context, model CoreweaveProviderModel) (coreweave.S3Config, error) {
+	s3 := coreweave.S3Config{
+		AccessKeyID:               model.S3AccessKeyID.ValueString(),
+		SecretAccessKey:           model.S3SecretAccessKey.ValueString(),
+		UsePathStyle:              model.S3UsePathStyle.ValueBool(),
+		SkipCredentialsValidation: model.S3SkipCredentialsValidation.ValueBool(),
+	}
+
+	if accessKeyID, ok := os.LookupEnv(CoreweaveS3AccessKeyIDEnvVar); ok {
+		s3.AccessKeyID = accessKeyID
+	}
+	if secretAccessKey, ok := os.LookupEnv(CoreweaveS3SecretAccessKeyEnvVar); ok {
+		s3.SecretAccessKey = secretAccessKey
+	}
+
+	lookupBool := func(envVar string, value *bool) {
+		raw, ok := os.LookupEnv(envVar)
+		if !ok {
+			return
+		}
+		if parsed, err := strconv.ParseBool(raw); err == nil {
+			*value = parsed
+		} else {
+			tflog.Error(ctx, fmt.Sprintf("got invalid boolean '%s' for %s, using %t", raw, envVar, *value))
+		}
+	}
+	lookupBool(CoreweaveS3UsePathStyleEnvVar, &s3.UsePathStyle)
+	lookupBool(CoreweaveS3SkipCredentialsValidationEnvVar, &s3.SkipCredentialsValidation)
+
+	if (s3.AccessKeyID == "") != (s3.SecretAccessKey == "") {
+		return s3, errors.New("s3_access_key_id and s3_secret_access_key must be set together")
+	}
+
+	return s3, nil
+}
+
+// buildTransportConfig resolves the TLS and proxy settings for the API and S3 HTTP clients.
+// Variable precedence: 1) env, 2) config, 3) shared config file profile.
+func buildTransportConfig(model CoreweaveProviderModel, profile *ProfileConfig) coreweave.TransportConfig {
+	if profile == nil {
+		profile = &ProfileConfig{}
+	}
+
+	resolve := func(envVar string, value types.String, fromProfile string) string {
+		if fromEnv, ok := os.LookupEnv(envVar); ok {
+			return fromEnv
+		}
+		if v := value.ValueString(); v != "" {
+			return v
+		}
+		return fromProfile
+	}
+
+	return coreweave.TransportConfig{
+		CABundleFile:   resolve(CoreweaveCABundleFileEnvVar, model.CABundleFile, profile.CABundleFile),
+		ClientCertFile: resolve(CoreweaveClientCertFileEnvVar, model.ClientCertFile, profile.ClientCertFile),
+		ClientKeyFile:  resolve(CoreweaveClientKeyFileEnvVar, model.ClientKeyFile, profile.ClientKeyFile),
+		ProxyURL:       resolve(CoreweaveProxyURLEnvVar, model.ProxyURL, profile.ProxyURL),
+		NoProxy:        resolve(CoreweaveNoProxyEnvVar, model.NoProxy, profile.NoProxy),
+	}
+}
+
+// openCassette opens the cassette named by COREWEAVE_RECORD or COREWEAVE_REPLAY, if either is set.
+func openCassette() (*coreweave.Cassette, error) {
+	recordPath := os.Getenv(CoreweaveRecordEnvVar)
+	replayPath := os.Getenv(CoreweaveReplayEnvVar)
+
+	switch {
+	case recordPath != "" && replayPath != "":
+		return nil, fmt.Errorf("only one of %s and %s may be set", CoreweaveRecordEnvVar, CoreweaveReplayEnvVar)
+	case recordPath != "":
+		return coreweave.OpenCassette(recordPath, coreweave.CassetteRecord)
+	case replayPath != "":
+		return coreweave.OpenCassette(replayPath, coreweave.CassetteReplay)
+	default:
+		return nil, nil
+	}
+}
+
+// loadProfileConfig loads the selected profile from the shared config file.
+// Profile precedence: 1) env, 2) config, 3) "default". Config file precedence: 1) env, 2) config, 3) ~/.coreweave/config.
+// An explicitly selected profile or config file must exist and be valid, while the implicit default profile is optional:
+// if ~/.coreweave/config cannot be read or parsed, the error is logged and the file ignored when the token is set in the
+// environment or the provider configuration, so that it does not fail configurations that take the token from there.
+// Otherwise the file may hold the only token, and the error is returned.
+func loadProfileConfig(ctx context.Context, model CoreweaveProviderModel) (*ProfileConfig, error) {
+	name := model.Profile.ValueString()
+	if profileFromEnv, ok := os.LookupEnv(CoreweaveProfileEnvVar); ok && profileFromEnv != "" {
+		name = profileFromEnv
+	}
+	path := model.ConfigFile.ValueString()
+	if configFileFromEnv, ok := os.LookupEnv(CoreweaveConfigFileEnvVar); ok && configFileFromEnv != "" {
+		path = configFileFromEnv
+	}
+
+	required := name != "" || path != ""
+	if name == "" {
+		name = DefaultProfileName
+	}
+	if path == "" {
+		defaultPath, err := DefaultConfigFilePath()
+		if err != nil {
+			// without a home directory there is no default config file to read
+			return nil, nil //nolint:nilerr
+		}
+		path = defaultPath
+	}
+
+	tokenFromEnv, _ := os.LookupEnv(CoreweaveApiTokenEnvVar)
+	tokenElsewhere := tokenFromEnv != "" || model.Token.ValueString() != ""
+
+	profile, err := LoadProfile(path, name, required)
+	if err != nil && !required && tokenElsewhere {
+		tflog.Warn(ctx, "ignoring the default config file, which cannot be loaded", map[string]any{
+			"path":  path,
+			"error": err.Error(),
+		})
+		return nil, nil
+	}
+	return profile, err
+}
+
+// buildRetryConfig resolves the retry settings from the provider retry block, environment variables and defaults.
+// Variable precedence: 1) env, 2) config, 3) default.
+func buildRetryConfig(ctx context.Context, model *RetryModel) (coreweave.RetryConfig, error) {
+	retry := coreweave.DefaultRetryConfig()
+
+	if model != nil {
+		if !model.MaxAttempts.IsNull() {
+			retry.MaxAttempts = int(model.MaxAttempts.ValueInt64())
+		}
+		// as with http_timeout, the schema validators guarantee these parse
+		if minWait, err := parseDuration(model.MinWait.ValueString()); err == nil {
+			retry.MinWait = *minWait
+		}
+		if maxWait, err := parseDuration(model.MaxWait.ValueString()); err == nil {
+			retry.MaxWait = *maxWait
+		}
+	}
+
+	if maxAttemptsStr, ok := os.LookupEnv(CoreweaveRetryMaxAttemptsEnvVar); ok {
+		maxAttempts, err := strconv.Atoi(maxAttemptsStr)
+		if err == nil && maxAttempts >= 0 {
+			retry.MaxAttempts = maxAttempts
+		} else {
+			tflog.Error(ctx, fmt.Sprintf("got invalid retry count '%s' for %s, using %d", maxAttemptsStr, CoreweaveRetryMaxAttemptsEnvVar, retry.MaxAttempts))
+		}
+	}
+	if minWaitStr, ok := os.LookupEnv(CoreweaveRetryMinWaitEnvVar); ok {
+		if minWait, err := parseDuration(minWaitStr); err == nil {
+			retry.MinWait = *minWait
+		} else {
+			tflog.Error(ctx, fmt.Sprintf("got invalid duration '%s' for %s, using %v", minWaitStr, CoreweaveRetryMinWaitEnvVar, retry.MinWait))
+		}
+	}
+	if maxWaitStr, ok := os.LookupEnv(CoreweaveRetryMaxWaitEnvVar); ok {
+		if maxWait, err := parseDuration(maxWaitStr); err == nil {
+			retry.MaxWait = *maxWait
+		} else {
+			tflog.Error(ctx, fmt.Sprintf("got invalid duration '%s' for %s, using %v", maxWaitStr, CoreweaveRetryMaxWaitEnvVar, retry.MaxWait))
+		}
+	}
+
+	if retry.MinWait > retry.MaxWait {
+		return retry, fmt.Errorf("retry min_wait (%v) must not be greater than max_wait (%v)", retry.MinWait, retry.MaxWait)
+	}
+
+	return retry, nil
 }
 
 func (p *CoreweaveProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -222,16 +630,52 @@ func (p *CoreweaveProvider) Resources(ctx context.Context) []func() resource.Res
 func (p *CoreweaveProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		networking.NewVpcDataSource,
+		networking.NewVpcsDataSource,
 		cks.NewClusterDataSource,
+		cks.NewClustersDataSource,
+		objectstorage.NewBucketDataSource,
+		objectstorage.NewBucketsDataSource,
+		objectstorage.NewOrganizationAccessPoliciesDataSource,
 		objectstorage.NewBucketPolicyDocumentDataSource,
 		inference.NewInferenceDeploymentParametersDataSource,
 		inference.NewCapacityClaimParametersDataSource,
 		inference.NewGatewayParametersDataSource,
+		inference.NewDeploymentDataSource,
+		inference.NewDeploymentsDataSource,
+		inference.NewGatewayDataSource,
+		inference.NewGatewaysDataSource,
+		inference.NewCapacityClaimDataSource,
+		inference.NewCapacityClaimsDataSource,
+	}
+}
+
+func (p *CoreweaveProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		cks.NewKubeconfigEphemeralResource,
+		objectstorage.NewAccessKeyEphemeralResource,
+	}
+}
+
+func (p *CoreweaveProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		cks.NewClusterListResource,
+		networking.NewVpcListResource,
+		objectstorage.NewBucketListResource,
+		objectstorage.NewOrganizationAccessPolicyListResource,
+		inference.NewDeploymentListResource,
+		inference.NewCapacityClaimListResource,
+		inference.NewGatewayListResource,
 	}
 }
 
 func (p *CoreweaveProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		cks.NewNormalizeVersionFunction,
+		objectstorage.NewBucketArnFunction,
+		objectstorage.NewObjectArnFunction,
+		objectstorage.NewParseArnFunction,
+		objectstorage.NewParseS3URIFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
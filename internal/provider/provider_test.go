@@ -0,0 +1,353 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setEnv sets the environment variables in env and unsets the other keys for the duration of the test.
+func setEnv(t *testing.T, keys []string, env map[string]string) {
+	t.Helper()
+	for _, key := range keys {
+		// t.Setenv restores the variable after the test, so that it can be unset for its duration
+		t.Setenv(key, env[key])
+		if _, ok := env[key]; !ok {
+			require.NoError(t, os.Unsetenv(key))
+		}
+	}
+}
+
+func TestBuildRetryConfig(t *testing.T) {
+	tests := map[string]struct {
+		model   *RetryModel
+		env     map[string]string
+		want    coreweave.RetryConfig
+		wantErr string
+	}{
+		"unset": {
+			want: coreweave.DefaultRetryConfig(),
+		},
+		"config": {
+			model: &RetryModel{MaxAttempts: types.Int64Value(3), MinWait: types.StringValue("1s"), MaxWait: types.StringValue("10s")},
+			want:  coreweave.RetryConfig{MaxAttempts: 3, MinWait: time.Second, MaxWait: 10 * time.Second},
+		},
+		"config defaults unset settings": {
+			model: &RetryModel{MaxAttempts: types.Int64Null(), MinWait: types.StringNull(), MaxWait: types.StringValue("30s")},
+			want:  coreweave.RetryConfig{MaxAttempts: coreweave.DefaultRetryMaxAttempts, MinWait: coreweave.DefaultRetryMinWait, MaxWait: 30 * time.Second},
+		},
+		"zero max_attempts disables retries": {
+			model: &RetryModel{MaxAttempts: types.Int64Value(0), MinWait: types.StringNull(), MaxWait: types.StringNull()},
+			want:  coreweave.RetryConfig{MaxAttempts: 0, MinWait: coreweave.DefaultRetryMinWait, MaxWait: coreweave.DefaultRetryMaxWait},
+		},
+		"env takes precedence": {
+			model: &RetryModel{MaxAttempts: types.Int64Value(3), MinWait: types.StringValue("1s"), MaxWait: types.StringValue("10s")},
+			env: map[string]string{
+				CoreweaveRetryMaxAttemptsEnvVar: "0",
+				CoreweaveRetryMinWaitEnvVar:     "2s",
+				CoreweaveRetryMaxWaitEnvVar:     "1m",
+			},
+			want: coreweave.RetryConfig{MaxAttempts: 0, MinWait: 2 * time.Second, MaxWait: time.Minute},
+		},
+		"invalid env is ignored": {
+			model: &RetryModel{MaxAttempts: types.Int64Value(3), MinWait: types.StringValue("1s"), MaxWait: types.StringValue("10s")},
+			env: map[string]string{
+				CoreweaveRetryMaxAttemptsEnvVar: "many",
+				CoreweaveRetryMinWaitEnvVar:     "soon",
+				CoreweaveRetryMaxWaitEnvVar:     "later",
+			},
+			want: coreweave.RetryConfig{MaxAttempts: 3, MinWait: time.Second, MaxWait: 10 * time.Second},
+		},
+		"negative max attempts in env is ignored": {
+			env:  map[string]string{CoreweaveRetryMaxAttemptsEnvVar: "-1"},
+			want: coreweave.DefaultRetryConfig(),
+		},
+		"min_wait greater than max_wait": {
+			env:     map[string]string{CoreweaveRetryMinWaitEnvVar: "1m", CoreweaveRetryMaxWaitEnvVar: "1s"},
+			wantErr: "must not be greater than max_wait",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			setEnv(t, []string{CoreweaveRetryMaxAttemptsEnvVar, CoreweaveRetryMinWaitEnvVar, CoreweaveRetryMaxWaitEnvVar}, tt.env)
+
+			got, err := buildRetryConfig(t.Context(), tt.model)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBuildTransportConfig(t *testing.T) {
+	profile := &ProfileConfig{
+		CABundleFile:   "/profile/ca.pem",
+		ClientCertFile: "/profile/cert.pem",
+		ClientKeyFile:  "/profile/key.pem",
+		ProxyURL:       "http://profile-proxy:3128",
+		NoProxy:        "profile.internal",
+	}
+	model := CoreweaveProviderModel{
+		CABundleFile:   types.StringValue("/config/ca.pem"),
+		ClientCertFile: types.StringValue("/config/cert.pem"),
+		ClientKeyFile:  types.StringValue("/config/key.pem"),
+		ProxyURL:       types.StringValue("http://config-proxy:3128"),
+		NoProxy:        types.StringValue("config.internal"),
+	}
+
+	tests := map[string]struct {
+		model   CoreweaveProviderModel
+		profile *ProfileConfig
+		env     map[string]string
+		want    coreweave.TransportConfig
+	}{
+		"unset": {
+			want: coreweave.TransportConfig{},
+		},
+		"profile": {
+			profile: profile,
+			want: coreweave.TransportConfig{
+				CABundleFile:   "/profile/ca.pem",
+				ClientCertFile: "/profile/cert.pem",
+				ClientKeyFile:  "/profile/key.pem",
+				ProxyURL:       "http://profile-proxy:3128",
+				NoProxy:        "profile.internal",
+			},
+		},
+		"config takes precedence over the profile": {
+			model:   model,
+			profile: profile,
+			want: coreweave.TransportConfig{
+				CABundleFile:   "/config/ca.pem",
+				ClientCertFile: "/config/cert.pem",
+				ClientKeyFile:  "/config/key.pem",
+				ProxyURL:       "http://config-proxy:3128",
+				NoProxy:        "config.internal",
+			},
+		},
+		"env takes precedence over config": {
+			model:   model,
+			profile: profile,
+			env: map[string]string{
+				CoreweaveCABundleFileEnvVar:   "/env/ca.pem",
+				CoreweaveClientCertFileEnvVar: "/env/cert.pem",
+				CoreweaveClientKeyFileEnvVar:  "/env/key.pem",
+				CoreweaveProxyURLEnvVar:       "http://env-proxy:3128",
+				CoreweaveNoProxyEnvVar:        "",
+			},
+			want: coreweave.TransportConfig{
+				CABundleFile:   "/env/ca.pem",
+				ClientCertFile: "/env/cert.pem",
+				ClientKeyFile:  "/env/key.pem",
+				ProxyURL:       "http://env-proxy:3128",
+				NoProxy:        "",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			setEnv(t, []string{CoreweaveCABundleFileEnvVar, CoreweaveClientCertFileEnvVar, CoreweaveClientKeyFileEnvVar, CoreweaveProxyURLEnvVar, CoreweaveNoProxyEnvVar}, tt.env)
+
+			assert.Equal(t, tt.want, buildTransportConfig(tt.model, tt.profile))
+		})
+	}
+}
+
+func TestBuildTagConfig(t *testing.T) {
+	tags := types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("ml"), "env": types.StringValue("prod")})
+	keys := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("owner")})
+	prefixes := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("kubernetes.io/")})
+
+	tests := map[string]struct {
+		model CoreweaveProviderModel
+		want  *coreweave.TagConfig
+	}{
+		"unset": {
+			want: &coreweave.TagConfig{},
+		},
+		"default tags": {
+			model: CoreweaveProviderModel{DefaultTags: &DefaultTagsModel{Tags: tags}},
+			want:  &coreweave.TagConfig{DefaultTags: map[string]string{"team": "ml", "env": "prod"}},
+		},
+		"ignored tags": {
+			model: CoreweaveProviderModel{IgnoreTags: &IgnoreTagsModel{Keys: keys, KeyPrefixes: prefixes}},
+			want:  &coreweave.TagConfig{IgnoreKeys: []string{"owner"}, IgnoreKeyPrefixes: []string{"kubernetes.io/"}},
+		},
+		"empty blocks": {
+			model: CoreweaveProviderModel{
+				DefaultTags: &DefaultTagsModel{Tags: types.MapNull(types.StringType)},
+				IgnoreTags:  &IgnoreTagsModel{Keys: types.SetNull(types.StringType), KeyPrefixes: types.SetNull(types.StringType)},
+			},
+			want: &coreweave.TagConfig{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := buildTagConfig(t.Context(), tt.model)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBuildS3Config(t *testing.T) {
+	tests := map[string]struct {
+		model   CoreweaveProviderModel
+		env     map[string]string
+		want    coreweave.S3Config
+		wantErr string
+	}{
+		"unset": {
+			want: coreweave.S3Config{},
+		},
+		"config": {
+			model: CoreweaveProviderModel{
+				S3AccessKeyID:               types.StringValue("minio"),
+				S3SecretAccessKey:           types.StringValue("minio-secret"),
+				S3UsePathStyle:              types.BoolValue(true),
+				S3SkipCredentialsValidation: types.BoolValue(true),
+			},
+			want: coreweave.S3Config{
+				AccessKeyID:               "minio",
+				SecretAccessKey:           "minio-secret",
+				UsePathStyle:              true,
+				SkipCredentialsValidation: true,
+			},
+		},
+		"env takes precedence": {
+			model: CoreweaveProviderModel{
+				S3AccessKeyID:     types.StringValue("minio"),
+				S3SecretAccessKey: types.StringValue("minio-secret"),
+				S3UsePathStyle:    types.BoolValue(true),
+			},
+			env: map[string]string{
+				CoreweaveS3AccessKeyIDEnvVar:               "env",
+				CoreweaveS3SecretAccessKeyEnvVar:           "env-secret",
+				CoreweaveS3UsePathStyleEnvVar:              "false",
+				CoreweaveS3SkipCredentialsValidationEnvVar: "1",
+			},
+			want: coreweave.S3Config{
+				AccessKeyID:               "env",
+				SecretAccessKey:           "env-secret",
+				UsePathStyle:              false,
+				SkipCredentialsValidation: true,
+			},
+		},
+		"invalid boolean is ignored": {
+			model: CoreweaveProviderModel{S3UsePathStyle: types.BoolValue(true)},
+			env:   map[string]string{CoreweaveS3UsePathStyleEnvVar: "sometimes"},
+			want:  coreweave.S3Config{UsePathStyle: true},
+		},
+		"access key without secret": {
+			model:   CoreweaveProviderModel{S3AccessKeyID: types.StringValue("minio")},
+			wantErr: "must be set together",
+		},
+		"secret without access key": {
+			env:     map[string]string{CoreweaveS3SecretAccessKeyEnvVar: "env-secret"},
+			wantErr: "must be set together",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, key := range []string{CoreweaveS3AccessKeyIDEnvVar, CoreweaveS3SecretAccessKeyEnvVar, CoreweaveS3UsePathStyleEnvVar, CoreweaveS3SkipCredentialsValidationEnvVar} {
+				if value, ok := tt.env[key]; ok {
+					t.Setenv(key, value)
+				}
+			}
+
+			got, err := buildS3Config(t.Context(), tt.model)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBuildClient_StaticS3CredentialsWithoutToken(t *testing.T) {
+	t.Setenv(CoreweaveConfigFileEnvVar, "")
+	t.Setenv(CoreweaveProfileEnvVar, "")
+	t.Setenv("HOME", t.TempDir())
+	for _, key := range []string{CoreweaveApiTokenEnvVar, CoreweaveS3AccessKeyIDEnvVar, CoreweaveS3SecretAccessKeyEnvVar} {
+		// t.Setenv restores the variable after the test, so that it can be unset for its duration
+		t.Setenv(key, "")
+		require.NoError(t, os.Unsetenv(key))
+	}
+
+	_, err := BuildClient(t.Context(), CoreweaveProviderModel{}, "", "")
+	require.ErrorContains(t, err, "token is required")
+
+	client, err := BuildClient(t.Context(), CoreweaveProviderModel{
+		S3AccessKeyID:     types.StringValue("minio"),
+		S3SecretAccessKey: types.StringValue("minio-secret"),
+	}, "", "")
+	require.NoError(t, err)
+	assert.True(t, client.S3.HasStaticCredentials())
+}
+
+func TestBuildClient_InvalidDefaultConfigFile(t *testing.T) {
+	t.Setenv(CoreweaveConfigFileEnvVar, "")
+	t.Setenv(CoreweaveProfileEnvVar, "")
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	require.NoError(t, os.MkdirAll(filepath.Join(home, ".coreweave"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(home, ".coreweave", "config"), []byte("[default]\ntokn = CW-SECRET-default\n"), 0o600))
+	t.Setenv(CoreweaveApiTokenEnvVar, "")
+	require.NoError(t, os.Unsetenv(CoreweaveApiTokenEnvVar))
+
+	_, err := BuildClient(t.Context(), CoreweaveProviderModel{}, "", "")
+	require.ErrorContains(t, err, `unknown key "tokn"`, "the implicit config file may hold the only token")
+
+	client, err := BuildClient(t.Context(), CoreweaveProviderModel{Token: types.StringValue("CW-SECRET-hcl")}, "", "")
+	require.NoError(t, err, "an invalid implicit config file is ignored")
+	assert.Equal(t, "CW-SECRET-hcl", client.Token)
+
+	t.Setenv(CoreweaveApiTokenEnvVar, "CW-SECRET-env")
+	client, err = BuildClient(t.Context(), CoreweaveProviderModel{}, "", "")
+	require.NoError(t, err, "an invalid implicit config file is ignored")
+	assert.Equal(t, "CW-SECRET-env", client.Token)
+
+	_, err = BuildClient(t.Context(), CoreweaveProviderModel{Profile: types.StringValue(DefaultProfileName)}, "", "")
+	require.ErrorContains(t, err, `unknown key "tokn"`, "an explicitly selected profile must be valid")
+}
+
+func TestOpenCassette(t *testing.T) {
+	dir := t.TempDir()
+	recordPath := filepath.Join(dir, "record.json")
+
+	t.Setenv(CoreweaveRecordEnvVar, "")
+	t.Setenv(CoreweaveReplayEnvVar, "")
+	cassette, err := openCassette()
+	require.NoError(t, err)
+	assert.Nil(t, cassette)
+
+	t.Setenv(CoreweaveRecordEnvVar, recordPath)
+	cassette, err = openCassette()
+	require.NoError(t, err)
+	assert.Equal(t, recordPath, cassette.Path())
+	assert.Equal(t, coreweave.CassetteRecord, cassette.Mode())
+
+	t.Setenv(CoreweaveReplayEnvVar, recordPath)
+	_, err = openCassette()
+	require.ErrorContains(t, err, "only one of")
+
+	t.Setenv(CoreweaveRecordEnvVar, "")
+	_, err = openCassette()
+	require.ErrorIs(t, err, os.ErrNotExist, "a cassette must be recorded before it is replayed")
+}
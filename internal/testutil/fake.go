@@ -0,0 +1,206 @@
+package testutil
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
+	"github.com/coreweave/terraform-provider-coreweave/coreweave/fake"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// NewFakeClient returns a client of a fake CoreWeave API that settles every transition immediately, and is stopped
+// when the test ends.
+func NewFakeClient(t *testing.T) *coreweave.Client {
+	t.Helper()
+	return NewFakeClientWithS3(t, "")
+}
+
+// NewFakeClientWithS3 is like NewFakeClient, but its Object Storage S3 API is served at s3Endpoint, which the fake
+// API does not implement, with static path-style credentials. The S3 endpoint is the fake API if s3Endpoint is empty.
+func NewFakeClientWithS3(t *testing.T, s3Endpoint string) *coreweave.Client {
+	t.Helper()
+	return newFakeClient(t, s3Endpoint, fake.WithDelay(0))
+}
+
+// NewFakeClientWithOptions is like NewFakeClient, but the fake API is configured with opts, e.g. fake.WithDelay.
+func NewFakeClientWithOptions(t *testing.T, opts ...fake.Option) *coreweave.Client {
+	t.Helper()
+	return newFakeClient(t, "", append([]fake.Option{fake.WithDelay(0)}, opts...)...)
+}
+
+func newFakeClient(t *testing.T, s3Endpoint string, opts ...fake.Option) *coreweave.Client {
+	t.Helper()
+
+	server := httptest.NewServer(fake.New(opts...).Handler())
+	t.Cleanup(server.Close)
+	if s3Endpoint == "" {
+		s3Endpoint = server.URL
+	}
+
+	token := connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			req.Header().Set("Authorization", "Bearer test")
+			return next(ctx, req)
+		}
+	})
+	client, err := coreweave.NewClient(server.URL, s3Endpoint, 10*time.Second, coreweave.RetryConfig{}, coreweave.TransportConfig{}, token)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.S3 = coreweave.S3Config{
+		AccessKeyID:               "test",
+		SecretAccessKey:           "test",
+		UsePathStyle:              true,
+		SkipCredentialsValidation: true,
+	}
+	return client
+}
+
+// ReadDataSource configures d with client and reads it with the given configuration, which maps the names of
+// top-level attributes to their values, e.g. strings. Attributes missing from config are null. It returns the
+// resulting state, to be decoded with its Get method.
+func ReadDataSource(t *testing.T, d datasource.DataSource, client *coreweave.Client, config map[string]any) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+	ctx := t.Context()
+
+	var configureResp datasource.ConfigureResponse
+	d.(datasource.DataSourceWithConfigure).Configure(ctx, datasource.ConfigureRequest{ProviderData: client}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		return tfsdk.State{}, configureResp.Diagnostics
+	}
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatalf("expected the schema to be an object, got %T", schemaResp.Schema.Type().TerraformType(ctx))
+	}
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, config[name])
+	}
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}
+	resp := datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	d.Read(ctx, req, &resp)
+	return resp.State, resp.Diagnostics
+}
+
+// ListResource configures l with client and lists the instances of its managed resource r with the given configuration,
+// which is like that of ReadDataSource, up to limit results unless it is 0. The resource of each result is only set if
+// includeResource is. It returns every result, with the diagnostics of the list as a result of their own if it fails.
+func ListResource(t *testing.T, l list.ListResource, r resource.Resource, client *coreweave.Client, config map[string]any, includeResource bool, limit int64) []list.ListResult {
+	t.Helper()
+	ctx := t.Context()
+
+	var configureResp resource.ConfigureResponse
+	l.(list.ListResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: client}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		return []list.ListResult{{Diagnostics: configureResp.Diagnostics}}
+	}
+
+	var configSchemaResp list.ListResourceSchemaResponse
+	l.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &configSchemaResp)
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identitySchemaResp resource.IdentitySchemaResponse
+	r.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+
+	objectType, ok := configSchemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatalf("expected the schema to be an object, got %T", configSchemaResp.Schema.Type().TerraformType(ctx))
+	}
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, config[name])
+	}
+
+	req := list.ListRequest{
+		Config:                 tfsdk.Config{Schema: configSchemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+		IncludeResource:        includeResource,
+		Limit:                  limit,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: identitySchemaResp.IdentitySchema,
+	}
+	var stream list.ListResultsStream
+	l.List(ctx, req, &stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		results = append(results, result)
+	}
+	return results
+}
+
+// ConfigureResource configures r with client, and returns r.
+func ConfigureResource[R resource.Resource](t *testing.T, r R, client *coreweave.Client) R {
+	t.Helper()
+
+	var resp resource.ConfigureResponse
+	any(r).(resource.ResourceWithConfigure).Configure(t.Context(), resource.ConfigureRequest{ProviderData: client}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("failed to configure resource: %v", resp.Diagnostics)
+	}
+	return r
+}
+
+// ResourceState returns the state of r set from model, a pointer to its resource model, and an empty identity of r, to
+// call the methods of r with.
+func ResourceState(t *testing.T, r resource.Resource, model any) (tfsdk.State, *tfsdk.ResourceIdentity) {
+	t.Helper()
+	ctx := t.Context()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diagnostics := state.Set(ctx, model); diagnostics.HasError() {
+		t.Fatalf("failed to set state: %v", diagnostics)
+	}
+
+	var identitySchemaResp resource.IdentitySchemaResponse
+	r.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+	identity := &tfsdk.ResourceIdentity{
+		Schema: identitySchemaResp.IdentitySchema,
+		Raw:    tftypes.NewValue(identitySchemaResp.IdentitySchema.Type().TerraformType(ctx), nil),
+	}
+	return state, identity
+}
+
+// NewPrivateState sets *private, the Private field of a resource request or response, to empty private state, as the
+// framework does before calling the resource.
+func NewPrivateState[T any](private **T) {
+	*private = new(T)
+}
+
+// Timeouts returns the timeouts block of a resource whose schema uses timeouts.BlockAll, with the given timeouts, e.g.
+// "create", set to their values and the others null.
+func Timeouts(t *testing.T, values map[string]string) timeouts.Value {
+	t.Helper()
+
+	blockType, _ := timeouts.BlockAll(t.Context()).Type().(timeouts.Type)
+	attributes := make(map[string]attr.Value, len(blockType.AttrTypes))
+	for name := range blockType.AttrTypes {
+		attributes[name] = types.StringNull()
+		if value, ok := values[name]; ok {
+			attributes[name] = types.StringValue(value)
+		}
+	}
+	return timeouts.Value{Object: types.ObjectValueMust(blockType.AttrTypes, attributes)}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"flag"
 	"log"
+	"time"
 
+	"github.com/coreweave/terraform-provider-coreweave/coreweave"
 	"github.com/coreweave/terraform-provider-coreweave/internal/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
@@ -27,7 +29,21 @@ func main() {
 		Debug:   debug,
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	// tracing is opt-in through the standard OTEL_* environment variables; a broken exporter configuration
+	// should not prevent the provider from running
+	shutdownTracing, err := coreweave.SetupTracing(context.Background(), version)
+	if err != nil {
+		log.Printf("[WARN] failed to set up OpenTelemetry tracing: %s", err)
+	}
+
+	err = providerserver.Serve(context.Background(), provider.New(version), opts)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	if shutdownErr := shutdownTracing(ctx); shutdownErr != nil {
+		log.Printf("[WARN] failed to flush OpenTelemetry traces: %s", shutdownErr)
+	}
+	cancel()
+
 	if err != nil {
 		log.Fatal(err.Error())
 	}